@@ -7,28 +7,29 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
-	"time"
 
 	"github.com/mrth1995/go-mockva/pkg/config"
+	"github.com/mrth1995/go-mockva/pkg/logging"
 	"github.com/mrth1995/go-mockva/pkg/server"
 	"github.com/sirupsen/logrus"
 )
 
-const millisecondTimeFormat = "2006-01-02T15:04:05.999Z07:00"
-
 func main() {
-	//setup logrus
-	logrus.SetLevel(logrus.InfoLevel)
-	logrus.SetOutput(os.Stdout)
-	logrus.SetFormatter(&logrus.TextFormatter{
-		FullTimestamp:   true,
-		TimestampFormat: millisecondTimeFormat,
-	})
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := migrate(os.Args[2:]); err != nil {
+			logrus.Fatalf("mockva migrate: %v", err)
+		}
+		return
+	}
 
-	cfg, err := config.ParseConfiguration()
+	cfg, err := config.ParseConfiguration(os.Args[1:])
 	if err != nil {
 		logrus.Fatalf("unable to parse configuration %v", err)
 	}
+	//setup logrus
+	if err = logging.Setup(cfg.LogFormat, cfg.LogLevel); err != nil {
+		logrus.Fatalf("unable to setup logging %v", err)
+	}
 
 	//setup server
 	httpServer := server.Server{}
@@ -40,14 +41,10 @@ func main() {
 	go func() {
 		<-sig
 
-		shutdownCtx, shutdownRelease := context.WithTimeout(context.Background(), 5*time.Second)
-		defer shutdownRelease()
-
 		logrus.Infof("OS quit signal received")
-		if err = httpServer.Stop(shutdownCtx); err != nil {
+		if err = httpServer.Stop(context.Background()); err != nil {
 			logrus.Errorf("unable to shutdown server: %v", err)
 		}
-		//handle close database
 		logrus.Info("Server stopped")
 		close(idleConnectionChan)
 	}()
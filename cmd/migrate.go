@@ -0,0 +1,38 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"os"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/mrth1995/go-mockva/pkg/config"
+	"github.com/mrth1995/go-mockva/pkg/logging"
+	"github.com/mrth1995/go-mockva/pkg/migration"
+)
+
+// migrate runs `mockva migrate up|down|goto N|force N|status [flags]` against the configured
+// database and prints the status of its schema.
+func migrate(args []string) error {
+	command, flags, err := migration.ParseCommand(args)
+	if err != nil {
+		return err
+	}
+	cfg, err := config.ParseConfiguration(flags)
+	if err != nil {
+		return err
+	}
+	if err = logging.Setup(cfg.LogFormat, cfg.LogLevel); err != nil {
+		return err
+	}
+
+	DB, err := sql.Open("pgx", cfg.PostgresDSN())
+	if err != nil {
+		return err
+	}
+	dbMigration, err := migration.NewMigration(DB, cfg.DBName)
+	if err != nil {
+		return errors.Join(err, DB.Close())
+	}
+	return errors.Join(dbMigration.Run(command, os.Stdout), dbMigration.Close())
+}
@@ -0,0 +1,21 @@
+// Package apidocs documents the routes of mockva as an OpenAPI 3.1 document and serves it with the
+// Swagger UI embedded in the binary.
+package apidocs
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+)
+
+//go:embed swagger-ui
+var files embed.FS
+
+// UIHandler serves the Swagger UI, which loads the document from openapi.json next to its index.
+func UIHandler() http.Handler {
+	ui, err := fs.Sub(files, "swagger-ui")
+	if err != nil {
+		panic(err)
+	}
+	return http.FileServer(http.FS(ui))
+}
@@ -0,0 +1,30 @@
+package apidocs
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUIHandler(t *testing.T) {
+	server := httptest.NewServer(http.StripPrefix("/mockva/apidocs/", UIHandler()))
+	defer server.Close()
+
+	assertions := require.New(t)
+	for path, contains := range map[string]string{
+		"/mockva/apidocs/":                       "swagger-ui-bundle.js",
+		"/mockva/apidocs/swagger-initializer.js": `url: "openapi.json"`,
+		"/mockva/apidocs/swagger-ui-bundle.js":   "SwaggerUIBundle",
+	} {
+		response, err := http.Get(server.URL + path)
+		assertions.Nil(err)
+		body, err := io.ReadAll(response.Body)
+		_ = response.Body.Close()
+		assertions.Nil(err)
+		assertions.Equal(http.StatusOK, response.StatusCode, path)
+		assertions.Contains(string(body), contains, path)
+	}
+}
@@ -0,0 +1,507 @@
+package apidocs
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/go-openapi/spec"
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	endpointError "github.com/mrth1995/go-mockva/pkg/errors"
+)
+
+// OpenAPIVersion is the version of the OpenAPI specification the document follows.
+const OpenAPIVersion = "3.1.0"
+
+// Names of the security schemes of the document.
+const (
+	SchemeOAuth2   = "oauth2"
+	SchemeClientID = "clientId"
+	SchemeAPIKey   = "apiKey"
+	SchemeAdminKey = "adminKey"
+)
+
+const (
+	definitionsPrefix = "#/definitions/"
+	schemasPrefix     = "#/components/schemas/"
+	// errorSchemasPath is the path of the route only declared so that the error schemas are
+	// generated, it is removed from the document.
+	errorSchemasPath = "/apidocs/error-schemas"
+)
+
+var (
+	endpointErrorSchema = schemaName(endpointError.EndpointError{})
+	problemSchema       = schemaName(endpointError.Problem{})
+)
+
+// Options describe the document beyond what the routes declare.
+type Options struct {
+	Title       string
+	Description string
+	Version     string
+	Contact     Contact
+	// Servers are the base URLs requests are sent to, the host serving the document when empty.
+	Servers []string
+	// TokenURL is the OAuth2 token endpoint. The routes requiring scopes are secured with OAuth2
+	// bearer tokens or API keys when it is set.
+	TokenURL string
+	// AdminKeyHeader is the header of the admin key. The admin routes are secured with it when set.
+	AdminKeyHeader string
+}
+
+// Document is an OpenAPI 3.1 document.
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Servers    []Server            `json:"servers,omitempty"`
+	Tags       []spec.Tag          `json:"tags,omitempty"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+type Info struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description,omitempty"`
+	Version     string   `json:"version"`
+	Contact     *Contact `json:"contact,omitempty"`
+}
+
+type Contact struct {
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+	URL   string `json:"url,omitempty"`
+}
+
+type Server struct {
+	URL string `json:"url"`
+}
+
+// PathItem holds the operations of a path by lowercase HTTP method.
+type PathItem map[string]*Operation
+
+type Operation struct {
+	Tags        []string              `json:"tags,omitempty"`
+	Summary     string                `json:"summary,omitempty"`
+	Description string                `json:"description,omitempty"`
+	OperationID string                `json:"operationId,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Deprecated  bool                  `json:"deprecated,omitempty"`
+	Security    []SecurityRequirement `json:"security,omitempty"`
+}
+
+type Parameter struct {
+	Name        string       `json:"name"`
+	In          string       `json:"in"`
+	Description string       `json:"description,omitempty"`
+	Required    bool         `json:"required,omitempty"`
+	Schema      *spec.Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Description string               `json:"description,omitempty"`
+	Required    bool                 `json:"required,omitempty"`
+	Content     map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema   *spec.Schema       `json:"schema,omitempty"`
+	Examples map[string]Example `json:"examples,omitempty"`
+}
+
+type Example struct {
+	Summary string `json:"summary,omitempty"`
+	Value   any    `json:"value"`
+}
+
+type Components struct {
+	Schemas         map[string]*spec.Schema   `json:"schemas,omitempty"`
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+type SecurityScheme struct {
+	Type        string      `json:"type"`
+	Description string      `json:"description,omitempty"`
+	Name        string      `json:"name,omitempty"`
+	In          string      `json:"in,omitempty"`
+	Flows       *OAuthFlows `json:"flows,omitempty"`
+}
+
+type OAuthFlows struct {
+	ClientCredentials *OAuthFlow `json:"clientCredentials,omitempty"`
+}
+
+type OAuthFlow struct {
+	TokenURL   string            `json:"tokenUrl"`
+	RefreshURL string            `json:"refreshUrl,omitempty"`
+	Scopes     map[string]string `json:"scopes"`
+}
+
+// SecurityRequirement maps the schemes a request must satisfy together to the scopes they need.
+type SecurityRequirement map[string][]string
+
+// Build documents the routes of webServices. The schemas come from the models the routes read and
+// return, error responses get the schemas and examples of the error catalog, also as problem
+// details when the route produces them, and the routes are secured with the schemes of options.
+//
+// Parameters:
+//   - webServices: The web services whose routes are documented
+//   - options: The information, servers and security of the document
+//
+// Returns:
+//   - *Document: The OpenAPI 3.1 document
+func Build(webServices []*restful.WebService, options Options) *Document {
+	swagger := restfulspec.BuildSwagger(restfulspec.Config{WebServices: append(slices.Clone(webServices), errorSchemas())})
+	delete(swagger.Paths.Paths, errorSchemasPath)
+
+	document := &Document{
+		OpenAPI: OpenAPIVersion,
+		Info: Info{
+			Title:       options.Title,
+			Description: options.Description,
+			Version:     options.Version,
+		},
+		Tags:  swagger.Tags,
+		Paths: make(map[string]PathItem, len(swagger.Paths.Paths)),
+		Components: Components{
+			Schemas:         make(map[string]*spec.Schema, len(swagger.Definitions)),
+			SecuritySchemes: securitySchemes(options),
+		},
+	}
+	if options.Contact != (Contact{}) {
+		document.Info.Contact = &options.Contact
+	}
+	for _, url := range options.Servers {
+		document.Servers = append(document.Servers, Server{URL: url})
+	}
+	for name, definition := range swagger.Definitions {
+		schema := definition
+		convertSchema(&schema)
+		document.Components.Schemas[name] = &schema
+	}
+	for path, pathItem := range swagger.Paths.Paths {
+		item := PathItem{}
+		for method, operation := range operations(pathItem) {
+			item[method] = convertOperation(swagger, operation)
+		}
+		document.Paths[path] = item
+	}
+	for _, ws := range webServices {
+		for _, route := range ws.Routes() {
+			operation := document.Paths[route.Path][strings.ToLower(route.Method)]
+			if operation == nil {
+				continue
+			}
+			secure(operation, route, options)
+			addErrorResponse(operation, http.StatusTooManyRequests, route.Produces)
+			addErrorResponse(operation, http.StatusServiceUnavailable, route.Produces)
+		}
+	}
+	return document
+}
+
+// Handler serves the document as JSON.
+func (document *Document) Handler() (http.Handler, error) {
+	body, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", restful.MIME_JSON)
+		_, _ = w.Write(body)
+	}), nil
+}
+
+// errorSchemas declares the error models no route returns directly, the problem details are only
+// written when the client asks for them.
+func errorSchemas() *restful.WebService {
+	ws := new(restful.WebService)
+	ws.Route(ws.GET(errorSchemasPath).
+		To(func(*restful.Request, *restful.Response) {}).
+		Returns(http.StatusOK, "Error schemas", endpointError.Problem{}))
+	return ws
+}
+
+func securitySchemes(options Options) map[string]SecurityScheme {
+	schemes := make(map[string]SecurityScheme)
+	if options.TokenURL != "" {
+		schemes[SchemeOAuth2] = SecurityScheme{
+			Type:        "oauth2",
+			Description: "Bearer tokens issued to the API clients",
+			Flows: &OAuthFlows{ClientCredentials: &OAuthFlow{
+				TokenURL:   options.TokenURL,
+				RefreshURL: options.TokenURL,
+				Scopes:     auth.Scopes,
+			}},
+		}
+		schemes[SchemeClientID] = SecurityScheme{Type: "apiKey", Description: "ID of the API client, sent with its API key", Name: "X-CLIENT-ID", In: "header"}
+		schemes[SchemeAPIKey] = SecurityScheme{Type: "apiKey", Description: "API key of the API client", Name: "X-API-KEY", In: "header"}
+	}
+	if options.AdminKeyHeader != "" {
+		schemes[SchemeAdminKey] = SecurityScheme{Type: "apiKey", Description: "Admin key of ADMIN_API_KEY", Name: options.AdminKeyHeader, In: "header"}
+	}
+	return schemes
+}
+
+// secure adds the security requirements of route to operation, and the responses of the requests
+// that do not meet them.
+func secure(operation *Operation, route restful.Route, options Options) {
+	scopes, _ := route.Metadata[auth.RouteScopesKey].([]string)
+	admin, _ := route.Metadata[auth.RouteAdminKey].(bool)
+	switch {
+	case len(scopes) > 0 && options.TokenURL != "":
+		operation.Security = []SecurityRequirement{
+			{SchemeOAuth2: scopes},
+			{SchemeClientID: {}, SchemeAPIKey: {}},
+		}
+		addErrorResponse(operation, http.StatusForbidden, route.Produces)
+	case admin && options.AdminKeyHeader != "":
+		operation.Security = []SecurityRequirement{{SchemeAdminKey: {}}}
+	default:
+		return
+	}
+	addErrorResponse(operation, http.StatusUnauthorized, route.Produces)
+}
+
+// addErrorResponse documents the catalog error of status on the operations returning catalog
+// errors, unless the route already declares the status.
+func addErrorResponse(operation *Operation, status int, produces []string) {
+	code := strconv.Itoa(status)
+	if _, declared := operation.Responses[code]; declared || !returnsCatalogErrors(operation) {
+		return
+	}
+	operation.Responses[code] = &Response{
+		Description: http.StatusText(status),
+		Content:     errorContent(status, http.StatusText(status), produces),
+	}
+}
+
+func returnsCatalogErrors(operation *Operation) bool {
+	for _, response := range operation.Responses {
+		if mediaType, ok := response.Content[restful.MIME_JSON]; ok && mediaType.Schema != nil &&
+			mediaType.Schema.Ref.String() == schemasPrefix+endpointErrorSchema {
+			return true
+		}
+	}
+	return false
+}
+
+func operations(pathItem spec.PathItem) map[string]*spec.Operation {
+	methods := map[string]*spec.Operation{
+		"get":     pathItem.Get,
+		"put":     pathItem.Put,
+		"post":    pathItem.Post,
+		"delete":  pathItem.Delete,
+		"options": pathItem.Options,
+		"head":    pathItem.Head,
+		"patch":   pathItem.Patch,
+	}
+	for method, operation := range methods {
+		if operation == nil {
+			delete(methods, method)
+		}
+	}
+	return methods
+}
+
+func convertOperation(swagger *spec.Swagger, operation *spec.Operation) *Operation {
+	consumes := mediaTypes(operation.Consumes, swagger.Consumes)
+	produces := mediaTypes(operation.Produces, swagger.Produces)
+	converted := &Operation{
+		Tags:        operation.Tags,
+		Summary:     operation.Summary,
+		Description: operation.Description,
+		OperationID: operation.ID,
+		Deprecated:  operation.Deprecated,
+		Responses:   make(map[string]*Response),
+	}
+
+	form := &spec.Schema{SchemaProps: spec.SchemaProps{Type: spec.StringOrArray{"object"}}}
+	for _, parameter := range operation.Parameters {
+		switch parameter.In {
+		case "body":
+			schema := parameter.Schema
+			convertSchema(schema)
+			converted.RequestBody = &RequestBody{Description: parameter.Description, Required: parameter.Required, Content: content(consumes, schema)}
+		case "formData":
+			property := *parameterSchema(parameter.SimpleSchema, parameter.CommonValidations)
+			property.Description = parameter.Description
+			form.SetProperty(parameter.Name, property)
+			if parameter.Required {
+				form.AddRequired(parameter.Name)
+			}
+		default:
+			converted.Parameters = append(converted.Parameters, Parameter{
+				Name:        parameter.Name,
+				In:          parameter.In,
+				Description: parameter.Description,
+				Required:    parameter.Required,
+				Schema:      parameterSchema(parameter.SimpleSchema, parameter.CommonValidations),
+			})
+		}
+	}
+	if len(form.Properties) > 0 {
+		converted.RequestBody = &RequestBody{Required: len(form.Required) > 0, Content: content(consumes, form)}
+	}
+
+	if operation.Responses != nil {
+		for status, response := range operation.Responses.StatusCodeResponses {
+			converted.Responses[strconv.Itoa(status)] = convertResponse(status, response, produces)
+		}
+		if response := operation.Responses.Default; response != nil {
+			converted.Responses["default"] = convertResponse(0, *response, produces)
+		}
+	}
+	return converted
+}
+
+func convertResponse(status int, response spec.Response, produces []string) *Response {
+	schema := response.Schema
+	convertSchema(schema)
+	if status >= http.StatusBadRequest && schema != nil && schema.Ref.String() == schemasPrefix+endpointErrorSchema {
+		return &Response{Description: response.Description, Content: errorContent(status, response.Description, produces)}
+	}
+	converted := &Response{Description: response.Description}
+	if schema != nil {
+		converted.Content = content(withoutProblem(produces), schema)
+	}
+	return converted
+}
+
+// errorContent returns the catalog error responses of status, as problem details too when they
+// are produced, with the catalog errors the response stands for as examples.
+func errorContent(status int, description string, produces []string) map[string]MediaType {
+	catalogErrors := catalogErrorsOf(status, description)
+	legacy := MediaType{Schema: schemaRef(endpointErrorSchema), Examples: make(map[string]Example, len(catalogErrors))}
+	problem := MediaType{Schema: schemaRef(problemSchema), Examples: make(map[string]Example, len(catalogErrors))}
+	for _, catalogError := range catalogErrors {
+		legacy.Examples[catalogError.ErrorCode] = Example{Summary: catalogError.ErrorMessage, Value: catalogError}
+		problem.Examples[catalogError.ErrorCode] = Example{Summary: catalogError.ErrorMessage, Value: endpointError.NewProblem(catalogError, status, "", "")}
+	}
+	errorContent := map[string]MediaType{restful.MIME_JSON: legacy}
+	if slices.Contains(produces, endpointError.ProblemMediaType) {
+		errorContent[endpointError.ProblemMediaType] = problem
+	}
+	return errorContent
+}
+
+// catalogErrorsOf returns the catalog error described by description. Descriptions of the generic
+// error of status, e.g. Conflict, are its message, and every catalog error of status is returned
+// when neither is found.
+func catalogErrorsOf(status int, description string) []*endpointError.EndpointError {
+	var catalogErrors []*endpointError.EndpointError
+	var generic *endpointError.EndpointError
+	for _, catalogError := range endpointError.Catalog() {
+		if catalogError.Status() != status {
+			continue
+		}
+		switch {
+		case strings.EqualFold(catalogError.ErrorMessage, description):
+			return []*endpointError.EndpointError{catalogError}
+		case strings.EqualFold(catalogError.ErrorMessage, http.StatusText(status)):
+			generic = &endpointError.EndpointError{ErrorMessage: description, ErrorCode: catalogError.ErrorCode, HTTPStatus: status}
+		}
+		catalogErrors = append(catalogErrors, catalogError)
+	}
+	if generic != nil {
+		return []*endpointError.EndpointError{generic}
+	}
+	return catalogErrors
+}
+
+func content(mediaTypes []string, schema *spec.Schema) map[string]MediaType {
+	converted := make(map[string]MediaType, len(mediaTypes))
+	for _, mediaType := range mediaTypes {
+		converted[mediaType] = MediaType{Schema: schema}
+	}
+	return converted
+}
+
+func mediaTypes(operation, global []string) []string {
+	switch {
+	case len(operation) > 0:
+		return operation
+	case len(global) > 0:
+		return global
+	}
+	return []string{restful.MIME_JSON}
+}
+
+func withoutProblem(mediaTypes []string) []string {
+	return slices.DeleteFunc(slices.Clone(mediaTypes), func(mediaType string) bool {
+		return mediaType == endpointError.ProblemMediaType
+	})
+}
+
+func parameterSchema(simple spec.SimpleSchema, validations spec.CommonValidations) *spec.Schema {
+	schema := &spec.Schema{SchemaProps: spec.SchemaProps{
+		Type:      spec.StringOrArray{simple.Type},
+		Format:    simple.Format,
+		Default:   simple.Default,
+		Enum:      validations.Enum,
+		Maximum:   validations.Maximum,
+		Minimum:   validations.Minimum,
+		MaxLength: validations.MaxLength,
+		MinLength: validations.MinLength,
+		Pattern:   validations.Pattern,
+	}}
+	if simple.Items != nil {
+		schema.Items = &spec.SchemaOrArray{Schema: parameterSchema(simple.Items.SimpleSchema, simple.Items.CommonValidations)}
+	}
+	return schema
+}
+
+// convertSchema points the references of schema to the components and gives the examples of
+// the struct tags, always strings, the type of their property.
+func convertSchema(schema *spec.Schema) {
+	if schema == nil {
+		return
+	}
+	if ref := schema.Ref.String(); strings.HasPrefix(ref, definitionsPrefix) {
+		schema.Ref = spec.MustCreateRef(schemasPrefix + strings.TrimPrefix(ref, definitionsPrefix))
+	}
+	if example, ok := schema.Example.(string); ok && len(schema.Type) == 1 && schema.Type[0] != "string" {
+		var value any
+		if json.Unmarshal([]byte(example), &value) == nil {
+			schema.Example = value
+		}
+	}
+	for name, property := range schema.Properties {
+		convertSchema(&property)
+		schema.Properties[name] = property
+	}
+	if schema.Items != nil {
+		convertSchema(schema.Items.Schema)
+		for i := range schema.Items.Schemas {
+			convertSchema(&schema.Items.Schemas[i])
+		}
+	}
+	if schema.AdditionalProperties != nil {
+		convertSchema(schema.AdditionalProperties.Schema)
+	}
+	for _, schemas := range [][]spec.Schema{schema.AllOf, schema.AnyOf, schema.OneOf} {
+		for i := range schemas {
+			convertSchema(&schemas[i])
+		}
+	}
+}
+
+func schemaRef(name string) *spec.Schema {
+	return spec.RefSchema(schemasPrefix + name)
+}
+
+// schemaName returns the name restfulspec gives the schema of model.
+func schemaName(model any) string {
+	return reflect.TypeOf(model).String()
+}
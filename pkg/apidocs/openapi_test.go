@@ -0,0 +1,145 @@
+package apidocs
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	endpointError "github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type transfer struct {
+	AccountID string   `json:"accountId" example:"1234567890"`
+	Amount    float64  `json:"amount" example:"150000"`
+	Tags      []string `json:"tags,omitempty" example:"[\"salary\"]"`
+}
+
+func testWebService() *restful.WebService {
+	ws := new(restful.WebService)
+	ws.Path("/mockva")
+	noop := func(*restful.Request, *restful.Response) {}
+	ws.Route(ws.POST("/transfers/{accountId}").
+		To(noop).
+		Doc("Transfer funds").
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON, endpointError.ProblemMediaType).
+		Param(ws.PathParameter("accountId", "Account ID")).
+		Param(ws.QueryParameter("dryRun", "Validate only").DataType("boolean")).
+		Reads(transfer{}).
+		Returns(http.StatusOK, "Transferred", transfer{}).
+		Returns(http.StatusNotFound, "Account not found", endpointError.EndpointError{}).
+		Returns(http.StatusConflict, "Transfer already exist", endpointError.EndpointError{}).
+		Metadata(auth.RouteScopesKey, []string{auth.ScopeTransfersWrite}))
+	ws.Route(ws.POST("/token").
+		To(noop).
+		Consumes("application/x-www-form-urlencoded").
+		Produces(restful.MIME_JSON).
+		Param(ws.FormParameter("grant_type", "Grant type").Required(true)).
+		Param(ws.FormParameter("scope", "Scopes")).
+		Returns(http.StatusBadRequest, "Invalid request", auth.OAuthError{}))
+	ws.Route(ws.GET("/admin").
+		To(noop).
+		Produces(restful.MIME_JSON).
+		Returns(http.StatusInternalServerError, "Internal server error", endpointError.EndpointError{}).
+		Metadata(auth.RouteAdminKey, true))
+	return ws
+}
+
+func TestBuild(t *testing.T) {
+	document := Build([]*restful.WebService{testWebService()}, Options{
+		Title:          "mockva",
+		Version:        "1.0.0",
+		Contact:        Contact{Name: "Maintainer", Email: "maintainer@example.com"},
+		Servers:        []string{"https://mockva.example.com"},
+		TokenURL:       "/mockva/token",
+		AdminKeyHeader: "X-ADMIN-KEY",
+	})
+
+	assertions := require.New(t)
+	assertions.Equal("3.1.0", document.OpenAPI)
+	assertions.Equal(Info{Title: "mockva", Version: "1.0.0", Contact: &Contact{Name: "Maintainer", Email: "maintainer@example.com"}}, document.Info)
+	assertions.Equal([]Server{{URL: "https://mockva.example.com"}}, document.Servers)
+	assertions.NotContains(document.Paths, errorSchemasPath)
+	assertions.Contains(document.Components.Schemas, "errors.Problem")
+	assertions.Contains(document.Components.Schemas, "errors.FieldError")
+	assertions.Equal("/mockva/token", document.Components.SecuritySchemes[SchemeOAuth2].Flows.ClientCredentials.TokenURL)
+	assertions.Equal("X-ADMIN-KEY", document.Components.SecuritySchemes[SchemeAdminKey].Name)
+
+	model := document.Components.Schemas["apidocs.transfer"]
+	assertions.NotNil(model)
+	assertions.Equal(150000.0, model.Properties["amount"].Example)
+	assertions.Equal([]any{"salary"}, model.Properties["tags"].Example)
+	assertions.Equal("1234567890", model.Properties["accountId"].Example)
+
+	transferOperation := document.Paths["/mockva/transfers/{accountId}"]["post"]
+	assertions.NotNil(transferOperation)
+	assertions.Equal([]SecurityRequirement{{SchemeOAuth2: {auth.ScopeTransfersWrite}}, {SchemeClientID: {}, SchemeAPIKey: {}}}, transferOperation.Security)
+	assertions.Len(transferOperation.Parameters, 2)
+	assertions.Equal("path", transferOperation.Parameters[0].In)
+	assertions.Equal("boolean", transferOperation.Parameters[1].Schema.Type[0])
+	assertions.Equal("#/components/schemas/apidocs.transfer", transferOperation.RequestBody.Content[restful.MIME_JSON].Schema.Ref.String())
+	assertions.Equal("#/components/schemas/apidocs.transfer", transferOperation.Responses["200"].Content[restful.MIME_JSON].Schema.Ref.String())
+	assertions.NotContains(transferOperation.Responses["200"].Content, endpointError.ProblemMediaType)
+	for _, status := range []string{"401", "403", "404", "409", "429", "503"} {
+		assertions.Contains(transferOperation.Responses, status)
+	}
+
+	notFound := transferOperation.Responses["404"].Content
+	assertions.Equal("#/components/schemas/errors.EndpointError", notFound[restful.MIME_JSON].Schema.Ref.String())
+	assertions.Equal(map[string]Example{endpointError.CodeAccountNotFound: {Summary: "Account not found", Value: endpointError.ErrAccountNotFound}}, notFound[restful.MIME_JSON].Examples)
+	assertions.Equal("#/components/schemas/errors.Problem", notFound[endpointError.ProblemMediaType].Schema.Ref.String())
+	problem := notFound[endpointError.ProblemMediaType].Examples[endpointError.CodeAccountNotFound].Value.(*endpointError.Problem)
+	assertions.Equal(http.StatusNotFound, problem.Status)
+	conflict := transferOperation.Responses["409"].Content[restful.MIME_JSON].Examples
+	assertions.Equal(map[string]Example{endpointError.CodeConflict: {
+		Summary: "Transfer already exist",
+		Value:   &endpointError.EndpointError{ErrorMessage: "Transfer already exist", ErrorCode: endpointError.CodeConflict, HTTPStatus: http.StatusConflict},
+	}}, conflict)
+
+	tokenOperation := document.Paths["/mockva/token"]["post"]
+	assertions.Nil(tokenOperation.Security)
+	assertions.Empty(tokenOperation.Parameters)
+	form := tokenOperation.RequestBody.Content["application/x-www-form-urlencoded"].Schema
+	assertions.Equal([]string{"grant_type"}, form.Required)
+	assertions.Contains(form.Properties, "scope")
+	assertions.Equal("#/components/schemas/auth.OAuthError", tokenOperation.Responses["400"].Content[restful.MIME_JSON].Schema.Ref.String())
+	assertions.NotContains(tokenOperation.Responses, "429")
+
+	adminOperation := document.Paths["/mockva/admin"]["get"]
+	assertions.Equal([]SecurityRequirement{{SchemeAdminKey: {}}}, adminOperation.Security)
+	assertions.Contains(adminOperation.Responses, "401")
+	assertions.NotContains(adminOperation.Responses, "403")
+}
+
+func TestBuild_WithoutSecurity(t *testing.T) {
+	document := Build([]*restful.WebService{testWebService()}, Options{Title: "mockva"})
+
+	assertions := require.New(t)
+	assertions.Empty(document.Components.SecuritySchemes)
+	assertions.Nil(document.Info.Contact)
+	assertions.Empty(document.Servers)
+	transferOperation := document.Paths["/mockva/transfers/{accountId}"]["post"]
+	assertions.Nil(transferOperation.Security)
+	assertions.NotContains(transferOperation.Responses, "401")
+	assertions.Contains(transferOperation.Responses, "503")
+	assertions.Nil(document.Paths["/mockva/admin"]["get"].Security)
+}
+
+func TestDocument_Handler(t *testing.T) {
+	handler, err := Build([]*restful.WebService{testWebService()}, Options{Title: "mockva", Version: "1.0.0"}).Handler()
+	assertions := require.New(t)
+	assertions.Nil(err)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/mockva/apidocs/openapi.json", nil))
+	assertions.Equal(http.StatusOK, recorder.Code)
+	assertions.Equal(restful.MIME_JSON, recorder.Header().Get("Content-Type"))
+	var document map[string]any
+	assertions.Nil(json.Unmarshal(recorder.Body.Bytes(), &document))
+	assertions.Equal("3.1.0", document["openapi"])
+	assertions.NotContains(string(recorder.Body.Bytes()), "#/definitions/")
+}
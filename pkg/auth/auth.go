@@ -0,0 +1,105 @@
+// Package auth issues and validates the bearer tokens of API clients and carries the
+// authenticated client through the request context.
+package auth
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// Scopes granted to API clients and required by the routes.
+const (
+	ScopeAccountsRead    = "accounts:read"
+	ScopeAccountsWrite   = "accounts:write"
+	ScopeTransfersRead   = "transfers:read"
+	ScopeTransfersWrite  = "transfers:write"
+	ScopeVirtualAccounts = "virtual-accounts"
+)
+
+// Scopes lists every scope an API client can be granted.
+var Scopes = map[string]string{
+	ScopeAccountsRead:    "Read accounts",
+	ScopeAccountsWrite:   "Create and edit accounts",
+	ScopeTransfersRead:   "Read transactions",
+	ScopeTransfersWrite:  "Transfer, deposit, withdraw and reverse funds",
+	ScopeVirtualAccounts: "Use the SNAP virtual account API",
+}
+
+// RouteScopesKey is the route metadata key holding the scopes, as a []string, a bearer token
+// needs to call the route. Routes without it do not require a token.
+const RouteScopesKey = "auth.scopes"
+
+// RouteAdminKey is the route metadata key marking, with true, the routes only the mockva admin
+// may call, such as the API client registry.
+const RouteAdminKey = "auth.admin"
+
+// Principal is the API client a request is made on behalf of.
+type Principal struct {
+	ClientID string
+	Scopes   []string
+}
+
+// HasScopes tells whether the principal was granted every given scope.
+func (p *Principal) HasScopes(scopes ...string) bool {
+	for _, scope := range scopes {
+		if !slices.Contains(p.Scopes, scope) {
+			return false
+		}
+	}
+	return true
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying principal.
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFrom returns the principal carried by ctx, if any.
+func PrincipalFrom(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok
+}
+
+// ParseScope splits a space separated OAuth2 scope parameter.
+func ParseScope(scope string) []string {
+	return strings.Fields(scope)
+}
+
+// FormatScope joins scopes into an OAuth2 scope parameter.
+func FormatScope(scopes []string) string {
+	return strings.Join(scopes, " ")
+}
+
+// OAuth2 error codes of RFC 6749 section 5.2.
+const (
+	OAuthInvalidRequest       = "invalid_request"
+	OAuthInvalidClient        = "invalid_client"
+	OAuthInvalidGrant         = "invalid_grant"
+	OAuthInvalidScope         = "invalid_scope"
+	OAuthUnsupportedGrantType = "unsupported_grant_type"
+)
+
+// OAuthError is an RFC 6749 token endpoint error response.
+type OAuthError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+	HTTPStatus  int    `json:"-"`
+}
+
+func (e *OAuthError) Error() string {
+	return e.Code + ": " + e.Description
+}
+
+// NewOAuthError creates an OAuthError. Failed client authentication is reported with 401,
+// everything else with 400.
+func NewOAuthError(code, description string) error {
+	status := http.StatusBadRequest
+	if code == OAuthInvalidClient {
+		status = http.StatusUnauthorized
+	}
+	return &OAuthError{Code: code, Description: description, HTTPStatus: status}
+}
@@ -0,0 +1,178 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+
+	tokenIssuer = "mockva"
+)
+
+var (
+	ErrTokenExpired = errors.New("token is expired")
+	ErrTokenInvalid = errors.New("token is invalid")
+)
+
+// Claims are the JWT claims of mockva tokens. The subject is the client ID.
+type Claims struct {
+	jwt.RegisteredClaims
+	Scope     string `json:"scope,omitempty"`
+	TokenType string `json:"token_type"`
+}
+
+// Token is a pair of access and refresh tokens issued to an API client.
+type Token struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    time.Duration
+	Scopes       []string
+}
+
+// TokenIssuer signs and validates JWT access and refresh tokens with a local key.
+type TokenIssuer struct {
+	signingKey crypto.Signer
+	method     jwt.SigningMethod
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+	now        func() time.Time
+}
+
+// NewTokenIssuer creates a new TokenIssuer.
+// Parameters:
+//   - signingKey: RSA key, signing RS256 tokens, or P-256 ECDSA key, signing ES256 tokens
+//   - accessTTL: How long access tokens are valid
+//   - refreshTTL: How long refresh tokens are valid
+//
+// Returns:
+//   - *TokenIssuer: The token issuer
+//   - error: If the key type is not supported
+func NewTokenIssuer(signingKey crypto.Signer, accessTTL, refreshTTL time.Duration) (*TokenIssuer, error) {
+	var method jwt.SigningMethod
+	switch key := signingKey.(type) {
+	case *rsa.PrivateKey:
+		method = jwt.SigningMethodRS256
+	case *ecdsa.PrivateKey:
+		if key.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("unsupported ECDSA curve %v, use P-256", key.Curve.Params().Name)
+		}
+		method = jwt.SigningMethodES256
+	default:
+		return nil, fmt.Errorf("unsupported signing key type %T", signingKey)
+	}
+	return &TokenIssuer{
+		signingKey: signingKey,
+		method:     method,
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+		now:        time.Now,
+	}, nil
+}
+
+// LoadSigningKey reads a PEM encoded RSA or ECDSA private key. When path is empty, a new P-256 key
+// is generated, so tokens do not survive a restart.
+func LoadSigningKey(path string) (crypto.Signer, error) {
+	if path == "" {
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, fmt.Errorf("%v is not PEM encoded", path)
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%v is not a PKCS#8, PKCS#1 or EC private key", path)
+}
+
+// Issue signs a new access and refresh token pair for the client.
+func (i *TokenIssuer) Issue(clientID string, scopes []string) (*Token, error) {
+	accessToken, err := i.sign(clientID, scopes, TokenTypeAccess, i.accessTTL)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := i.sign(clientID, scopes, TokenTypeRefresh, i.refreshTTL)
+	if err != nil {
+		return nil, err
+	}
+	return &Token{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    i.accessTTL,
+		Scopes:       scopes,
+	}, nil
+}
+
+// Parse validates a token of the given type and returns the client it was issued to.
+// Returns:
+//   - *Principal: The client and its granted scopes
+//   - error: ErrTokenExpired when the token is expired, ErrTokenInvalid for any other problem
+func (i *TokenIssuer) Parse(token, tokenType string) (*Principal, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) {
+		return i.signingKey.Public(), nil
+	},
+		jwt.WithValidMethods([]string{i.method.Alg()}),
+		jwt.WithIssuer(tokenIssuer),
+		jwt.WithTimeFunc(i.now),
+		jwt.WithExpirationRequired())
+	if errors.Is(err, jwt.ErrTokenExpired) {
+		return nil, ErrTokenExpired
+	}
+	if err != nil || claims.TokenType != tokenType || claims.Subject == "" {
+		return nil, ErrTokenInvalid
+	}
+	return &Principal{ClientID: claims.Subject, Scopes: ParseScope(claims.Scope)}, nil
+}
+
+func (i *TokenIssuer) sign(clientID string, scopes []string, tokenType string, ttl time.Duration) (string, error) {
+	now := i.now()
+	claims := Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        newTokenID(),
+			Issuer:    tokenIssuer,
+			Subject:   clientID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Scope:     FormatScope(scopes),
+		TokenType: tokenType,
+	}
+	return jwt.NewWithClaims(i.method, claims).SignedString(i.signingKey)
+}
+
+func newTokenID() string {
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenIssuer(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecdsaKey, err := LoadSigningKey("")
+	require.NoError(t, err)
+
+	for name, key := range map[string]crypto.Signer{"RS256": rsaKey, "ES256": ecdsaKey} {
+		t.Run(name, func(t *testing.T) {
+			assertions := require.New(t)
+			issuer, err := NewTokenIssuer(key, time.Minute, time.Hour)
+			assertions.NoError(err)
+
+			token, err := issuer.Issue("client-001", []string{ScopeAccountsRead, ScopeTransfersWrite})
+			assertions.NoError(err)
+			assertions.Equal(time.Minute, token.ExpiresIn)
+
+			principal, err := issuer.Parse(token.AccessToken, TokenTypeAccess)
+			assertions.NoError(err)
+			assertions.Equal("client-001", principal.ClientID)
+			assertions.True(principal.HasScopes(ScopeAccountsRead, ScopeTransfersWrite))
+			assertions.False(principal.HasScopes(ScopeAccountsWrite))
+
+			_, err = issuer.Parse(token.AccessToken, TokenTypeRefresh)
+			assertions.ErrorIs(err, ErrTokenInvalid, "an access token cannot be used as a refresh token")
+			_, err = issuer.Parse(token.RefreshToken, TokenTypeRefresh)
+			assertions.NoError(err)
+		})
+	}
+}
+
+func TestTokenIssuer_Expired(t *testing.T) {
+	key, err := LoadSigningKey("")
+	require.NoError(t, err)
+	issuer, err := NewTokenIssuer(key, time.Minute, time.Hour)
+	require.NoError(t, err)
+
+	token, err := issuer.Issue("client-001", nil)
+	require.NoError(t, err)
+
+	issuer.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+	_, err = issuer.Parse(token.AccessToken, TokenTypeAccess)
+	require.ErrorIs(t, err, ErrTokenExpired)
+	_, err = issuer.Parse(token.RefreshToken, TokenTypeRefresh)
+	require.NoError(t, err, "the refresh token outlives the access token")
+}
+
+func TestTokenIssuer_OtherKey(t *testing.T) {
+	key, err := LoadSigningKey("")
+	require.NoError(t, err)
+	otherKey, err := LoadSigningKey("")
+	require.NoError(t, err)
+	issuer, err := NewTokenIssuer(key, time.Minute, time.Hour)
+	require.NoError(t, err)
+	otherIssuer, err := NewTokenIssuer(otherKey, time.Minute, time.Hour)
+	require.NoError(t, err)
+
+	token, err := otherIssuer.Issue("client-001", nil)
+	require.NoError(t, err)
+	_, err = issuer.Parse(token.AccessToken, TokenTypeAccess)
+	require.ErrorIs(t, err, ErrTokenInvalid)
+}
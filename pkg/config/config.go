@@ -1,24 +1,76 @@
-// Package config is a package that stores environment variables
+// Package config is a package that stores the configuration of mockva. Every field can be set, from
+// the lowest to the highest precedence, by its default, a YAML or TOML config file, its environment
+// variable and its command line flag.
 package config
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"os"
 	"reflect"
-
-	"github.com/caarlos0/env"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Config struct {
-	Port             int    `env:"PORT" envDocs:"Application port" envDefault:"8080"`
-	PostgresPort     int    `env:"POSTGRES_PORT" envDocs:"PostgreSQL port"`
-	PostgresHost     string `env:"POSTGRES_HOST" envDocs:"PostgreSQL host"`
-	PostgresUsername string `env:"POSTGRES_USERNAME" envDocs:"PostgreSQL username"`
-	PostgresPassword string `env:"POSTGRES_PASSWORD" envDocs:"PostgreSQL password"`
-	DBName           string `env:"DB_NAME" envDocs:"Database name" envDefault:"mockva"`
-	SQLFilePath      string `env:"SQL_FILE_PATH" envDocs:"SQL file path for schema migration" envDefault:"/srv/migration"`
-	SwaggerFilePath  string `env:"SWAGGER_FILE_PATH"`
+	Port             int    `env:"PORT" envDocs:"Application port" envDefault:"8080" validate:"min=1,max=65535"`
+	PostgresPort     int    `env:"POSTGRES_PORT" envDocs:"PostgreSQL port" envDefault:"5432" validate:"min=1,max=65535"`
+	PostgresHost     string `env:"POSTGRES_HOST" envDocs:"PostgreSQL host" validate:"required"`
+	PostgresUsername string `env:"POSTGRES_USERNAME" envDocs:"PostgreSQL username" validate:"required"`
+	PostgresPassword string `env:"POSTGRES_PASSWORD" envDocs:"PostgreSQL password" secret:"true"`
+	DBName           string `env:"DB_NAME" envDocs:"Database name" envDefault:"mockva" validate:"required"`
+	AutoMigrate      bool   `env:"AUTO_MIGRATE" envDocs:"Migrate the database schema to the latest version when the server starts, otherwise run mockva migrate up" envDefault:"true"`
+
+	PostgresSSLMode          string        `env:"POSTGRES_SSL_MODE" envDocs:"PostgreSQL sslmode: disable, allow, prefer, require, verify-ca or verify-full" envDefault:"prefer" validate:"oneof=disable allow prefer require verify-ca verify-full"`
+	PostgresConnectTimeout   time.Duration `env:"POSTGRES_CONNECT_TIMEOUT" envDocs:"How long connecting to PostgreSQL may take, no limit when 0" envDefault:"5s" validate:"min=0"`
+	PostgresStatementTimeout time.Duration `env:"POSTGRES_STATEMENT_TIMEOUT" envDocs:"How long a query may run before it is cancelled, no limit when 0" envDefault:"30s" validate:"min=0"`
+	PostgresLockTimeout      time.Duration `env:"POSTGRES_LOCK_TIMEOUT" envDocs:"How long a query may wait for a row lock, e.g. of a balance, no limit when 0" envDefault:"5s" validate:"min=0"`
+	PostgresApplicationName  string        `env:"POSTGRES_APPLICATION_NAME" envDocs:"Application name of the connections, shown in pg_stat_activity" envDefault:"mockva"`
+	DBMaxOpenConns           int           `env:"DB_MAX_OPEN_CONNS" envDocs:"Connections the pool may open, unlimited when 0" envDefault:"25" validate:"min=0"`
+	DBMaxIdleConns           int           `env:"DB_MAX_IDLE_CONNS" envDocs:"Idle connections the pool keeps" envDefault:"5" validate:"min=0"`
+	DBConnMaxLifetime        time.Duration `env:"DB_CONN_MAX_LIFETIME" envDocs:"How long a connection is reused before it is closed, forever when 0" envDefault:"30m" validate:"min=0"`
+
+	APIDocsTitle        string `env:"APIDOCS_TITLE" envDocs:"Title of the API docs" envDefault:"mockva"`
+	APIDocsContactName  string `env:"APIDOCS_CONTACT_NAME" envDocs:"Contact name of the API docs" envDefault:"M Ridwan Taufik H"`
+	APIDocsContactEmail string `env:"APIDOCS_CONTACT_EMAIL" envDocs:"Contact email of the API docs" envDefault:"mr.taufikhidayat.1995@gmail.com"`
+	APIDocsContactURL   string `env:"APIDOCS_CONTACT_URL" envDocs:"Contact URL of the API docs" envDefault:"https://www.linkedin.com/in/m-ridwan-taufik-hidayat-775765138/"`
+	APIDocsServers      string `env:"APIDOCS_SERVERS" envDocs:"Comma separated base URLs the API docs send requests to, e.g. https://mockva.example.com,http://localhost:8080, the host serving them when empty"`
+
+	AsyncTransfer          bool          `env:"ASYNC_TRANSFER" envDocs:"Accept transfers as PENDING and settle them in the background" envDefault:"false"`
+	SettlementDelay        time.Duration `env:"SETTLEMENT_DELAY" envDocs:"How long an asynchronous transfer stays PENDING before it is settled" envDefault:"5s" validate:"min=0"`
+	SettlementPollInterval time.Duration `env:"SETTLEMENT_POLL_INTERVAL" envDocs:"How often the settlement worker looks for PENDING transfers" envDefault:"1s" validate:"gt=0"`
+
+	IDGenerator     string `env:"ID_GENERATOR" envDocs:"Transaction ID format: ulid, uuidv7 or reference" envDefault:"ulid" validate:"oneof=ulid uuidv7 reference"`
+	IDGeneratorSeed int64  `env:"ID_GENERATOR_SEED" envDocs:"Non-zero seed makes generated IDs reproducible, for tests against a fresh database only" envDefault:"0"`
+
+	SignatureVerification bool          `env:"SIGNATURE_VERIFICATION" envDocs:"Verify the X-SIGNATURE of SNAP requests with the keys of the registered API clients" envDefault:"false"`
+	SignatureClockSkew    time.Duration `env:"SIGNATURE_CLOCK_SKEW" envDocs:"How far X-TIMESTAMP of a signed request may be from the server clock" envDefault:"5m" validate:"min=0"`
+
+	AdminAPIKey         string        `env:"ADMIN_API_KEY" envDocs:"Key the admin sends in X-ADMIN-KEY to manage the API clients, the admin routes are disabled when empty" secret:"true"`
+	AuthEnabled         bool          `env:"AUTH_ENABLED" envDocs:"Require OAuth2 bearer tokens with the scopes of each route" envDefault:"false"`
+	TokenSigningKeyFile string        `env:"TOKEN_SIGNING_KEY_FILE" envDocs:"PEM RSA or P-256 ECDSA private key signing the tokens, a key generated at startup when empty"`
+	AccessTokenTTL      time.Duration `env:"ACCESS_TOKEN_TTL" envDocs:"How long access tokens are valid" envDefault:"15m" validate:"gt=0"`
+	RefreshTokenTTL     time.Duration `env:"REFRESH_TOKEN_TTL" envDocs:"How long refresh tokens are valid" envDefault:"24h" validate:"gt=0"`
+
+	RateLimit              int    `env:"RATE_LIMIT" envDocs:"Requests per minute the whole instance accepts, unlimited when 0" envDefault:"0" validate:"min=0"`
+	RouteRateLimits        string `env:"ROUTE_RATE_LIMITS" envDocs:"Requests per minute of routes, e.g. POST /mockva/accountTransactions/transfer=600,GET /mockva/accounts/{accountId}=1200"`
+	ClientConcurrencyLimit int    `env:"CLIENT_CONCURRENCY_LIMIT" envDocs:"Requests each API client may have in flight, unlimited when 0" envDefault:"0" validate:"min=0"`
+
+	TracingExporter    string  `env:"TRACING_EXPORTER" envDocs:"Where spans are exported: none, stdout or otlp (configured with the OTEL_EXPORTER_OTLP_* variables)" envDefault:"none" validate:"oneof=none stdout otlp"`
+	TracingSampleRatio float64 `env:"TRACING_SAMPLE_RATIO" envDocs:"Fraction of the traces started by mockva that are sampled, the callers decide for theirs" envDefault:"1" validate:"min=0,max=1"`
+
+	LogFormat string `env:"LOG_FORMAT" envDocs:"Format of the log lines: text or json" envDefault:"json" validate:"oneof=text json"`
+	LogLevel  string `env:"LOG_LEVEL" envDocs:"Lowest level logged: trace, debug, info, warn or error, debug logs every SQL query" envDefault:"info" validate:"oneof=trace debug info warn error"`
+
+	RequestTimeout time.Duration `env:"REQUEST_TIMEOUT" envDocs:"How long a request may take before its queries are cancelled, no limit when 0" envDefault:"30s" validate:"min=0"`
+	RouteTimeouts  string        `env:"ROUTE_TIMEOUTS" envDocs:"Timeouts of routes overriding REQUEST_TIMEOUT, e.g. POST /mockva/accountTransactions/transfer=10s,GET /mockva/accountTransactions=1m"`
+
+	ShutdownTimeout    time.Duration `env:"SHUTDOWN_TIMEOUT" envDocs:"How long the server waits for requests, background workers and the database to stop" envDefault:"5s" validate:"gt=0"`
+	ShutdownDrainDelay time.Duration `env:"SHUTDOWN_DRAIN_DELAY" envDocs:"How long /readyz answers 503 before the server stops, longer than the readiness probe period of the load balancer" envDefault:"5s" validate:"min=0"`
+
+	ErrorFormat string `env:"ERROR_FORMAT" envDocs:"Default error response format: legacy or problem (RFC 7807), clients can always ask for application/problem+json" envDefault:"legacy" validate:"oneof=legacy problem"`
 }
 
 func (envVar Config) HelpDocs() []string {
@@ -35,20 +87,51 @@ func (envVar Config) HelpDocs() []string {
 	return doc
 }
 
-func ParseConfiguration() (*Config, error) {
-	cfg := &Config{}
-	flag.Usage = func() {
-		flag.CommandLine.SetOutput(os.Stdout)
-		for _, val := range cfg.HelpDocs() {
-			fmt.Println(val)
+// PostgresDSN returns the connection string of the database. The statement and lock timeouts are
+// sent as session parameters, so PostgreSQL enforces them on every connection of the pool.
+func (envVar Config) PostgresDSN() string {
+	params := []struct {
+		key   string
+		value string
+	}{
+		{"host", envVar.PostgresHost},
+		{"port", strconv.Itoa(envVar.PostgresPort)},
+		{"user", envVar.PostgresUsername},
+		{"password", envVar.PostgresPassword},
+		{"dbname", envVar.DBName},
+		{"sslmode", envVar.PostgresSSLMode},
+		{"connect_timeout", strconv.Itoa(int(envVar.PostgresConnectTimeout / time.Second))},
+		{"application_name", envVar.PostgresApplicationName},
+		{"statement_timeout", strconv.FormatInt(envVar.PostgresStatementTimeout.Milliseconds(), 10)},
+		{"lock_timeout", strconv.FormatInt(envVar.PostgresLockTimeout.Milliseconds(), 10)},
+	}
+	dsn := make([]string, 0, len(params))
+	for _, param := range params {
+		if param.value == "" {
+			continue
 		}
-		fmt.Println("")
-		flag.PrintDefaults()
+		value := strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(param.value)
+		dsn = append(dsn, fmt.Sprintf("%s='%s'", param.key, value))
+	}
+	return strings.Join(dsn, " ")
+}
+
+// ParseConfiguration loads the configuration from the command line arguments, without the program
+// name, and the environment variables, see Load. With --print-config it prints the effective
+// configuration and exits.
+func ParseConfiguration(args []string) (*Config, error) {
+	cfg, printConfig, err := Load(args, os.LookupEnv)
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
 	}
-	flag.Parse()
-	err := env.Parse(cfg)
 	if err != nil {
 		return nil, err
 	}
+	if printConfig {
+		if err = cfg.Print(os.Stdout); err != nil {
+			return nil, err
+		}
+		os.Exit(0)
+	}
 	return cfg, nil
 }
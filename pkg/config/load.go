@@ -0,0 +1,276 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/go-playground/validator/v10"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// EnvConfigFile names the config file when --config is not given.
+	EnvConfigFile = "CONFIG_FILE"
+
+	redacted = "******"
+)
+
+// field is a Config field along with the names it is set by in each layer.
+type field struct {
+	index      int
+	env        string
+	key        string
+	flag       string
+	docs       string
+	envDefault string
+	secret     bool
+}
+
+var fields = configFields()
+
+// configFields derives the config file key and the flag of each field from its environment
+// variable, e.g. POSTGRES_HOST is postgres_host in config files and --postgres-host on the command line.
+func configFields() []field {
+	configType := reflect.TypeFor[Config]()
+	fields := make([]field, 0, configType.NumField())
+	for i := 0; i < configType.NumField(); i++ {
+		structField := configType.Field(i)
+		env := structField.Tag.Get("env")
+		fields = append(fields, field{
+			index:      i,
+			env:        env,
+			key:        strings.ToLower(env),
+			flag:       strings.ReplaceAll(strings.ToLower(env), "_", "-"),
+			docs:       structField.Tag.Get("envDocs"),
+			envDefault: structField.Tag.Get("envDefault"),
+			secret:     structField.Tag.Get("secret") == "true",
+		})
+	}
+	return fields
+}
+
+// flagValue records the raw value of a flag so that only the flags given on the command line
+// override the other layers.
+type flagValue struct {
+	value   string
+	set     bool
+	boolean bool
+}
+
+func (v *flagValue) String() string {
+	return v.value
+}
+
+func (v *flagValue) Set(value string) error {
+	v.value = value
+	v.set = true
+	return nil
+}
+
+func (v *flagValue) IsBoolFlag() bool {
+	return v.boolean
+}
+
+// Load builds the configuration from, in increasing precedence, the defaults, the config file
+// named by --config or CONFIG_FILE, the environment variables and the command line flags, then
+// validates it. Empty environment variables are ignored.
+// Parameters:
+//   - args: The command line arguments, without the program name
+//   - lookupEnv: Looks up environment variables, os.LookupEnv outside of tests
+//
+// Returns:
+//   - *Config: The effective configuration
+//   - bool: Whether --print-config was given
+//   - error: When a flag, the config file or a value is invalid, listing every invalid value
+func Load(args []string, lookupEnv func(string) (string, bool)) (*Config, bool, error) {
+	flagSet := flag.NewFlagSet("mockva", flag.ContinueOnError)
+	configFile := flagSet.String("config", "", "YAML or TOML config file, also set by "+EnvConfigFile)
+	printConfig := flagSet.Bool("print-config", false, "Print the effective configuration, secrets redacted, and exit")
+	flagValues := make([]*flagValue, len(fields))
+	configType := reflect.TypeFor[Config]()
+	for i, f := range fields {
+		flagValues[i] = &flagValue{boolean: configType.Field(f.index).Type.Kind() == reflect.Bool}
+		flagSet.Var(flagValues[i], f.flag, f.docs)
+	}
+	flagSet.Usage = func() {
+		output := flagSet.Output()
+		for _, line := range (Config{}).HelpDocs() {
+			fmt.Fprintln(output, line)
+		}
+		fmt.Fprintln(output, "")
+		flagSet.PrintDefaults()
+	}
+	flagSet.SetOutput(os.Stdout)
+	if err := flagSet.Parse(args); err != nil {
+		return nil, false, err
+	}
+
+	if *configFile == "" {
+		*configFile, _ = lookupEnv(EnvConfigFile)
+	}
+	fileValues := map[string]any{}
+	if *configFile != "" {
+		var err error
+		if fileValues, err = readFile(*configFile); err != nil {
+			return nil, false, err
+		}
+	}
+
+	cfg := &Config{}
+	value := reflect.ValueOf(cfg).Elem()
+	var errs []error
+	for i, f := range fields {
+		raw, source := f.envDefault, "default"
+		if fileValue, ok := fileValues[f.key]; ok {
+			if fileValue != nil {
+				raw, source = fmt.Sprint(fileValue), *configFile
+			}
+			delete(fileValues, f.key)
+		}
+		if envValue, ok := lookupEnv(f.env); ok && envValue != "" {
+			raw, source = envValue, f.env
+		}
+		if flagValues[i].set {
+			raw, source = flagValues[i].value, "--"+f.flag
+		}
+		if raw == "" {
+			continue
+		}
+		if err := setField(value.Field(f.index), raw); err != nil {
+			errs = append(errs, fmt.Errorf("%s: invalid value %q from %s: %v", f.env, raw, source, err))
+		}
+	}
+	for key := range fileValues {
+		errs = append(errs, fmt.Errorf("%s: unknown key %q", *configFile, key))
+	}
+	if len(errs) > 0 {
+		return nil, false, errors.Join(errs...)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, false, err
+	}
+	return cfg, *printConfig, nil
+}
+
+func readFile(path string) (map[string]any, error) {
+	var unmarshal func([]byte, any) error
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		unmarshal = yaml.Unmarshal
+	case ".toml":
+		unmarshal = toml.Unmarshal
+	default:
+		return nil, fmt.Errorf("config file %s must be .yaml, .yml or .toml", path)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read config file: %v", err)
+	}
+	values := map[string]any{}
+	if err = unmarshal(content, &values); err != nil {
+		return nil, fmt.Errorf("unable to parse config file %s: %v", path, err)
+	}
+	return values, nil
+}
+
+func setField(value reflect.Value, raw string) error {
+	if value.Type() == reflect.TypeFor[time.Duration]() {
+		duration, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		value.SetInt(int64(duration))
+		return nil
+	}
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		value.SetBool(b)
+	case reflect.Int, reflect.Int64:
+		i, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		value.SetInt(i)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		value.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %v", value.Type())
+	}
+	return nil
+}
+
+// Validate checks every value against the `validate` tag of its field.
+// Returns:
+//   - error: nil when the configuration is valid, otherwise lists every invalid value by its
+//     environment variable
+func (envVar *Config) Validate() error {
+	err := validator.New().Struct(envVar)
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return err
+	}
+	configType := reflect.TypeFor[Config]()
+	errs := make([]error, 0, len(validationErrors))
+	for _, fieldErr := range validationErrors {
+		structField, _ := configType.FieldByName(fieldErr.StructField())
+		errs = append(errs, fmt.Errorf("%s %s", structField.Tag.Get("env"), message(fieldErr)))
+	}
+	return errors.Join(errs...)
+}
+
+func message(fieldErr validator.FieldError) string {
+	switch fieldErr.Tag() {
+	case "required":
+		return "is required"
+	case "oneof":
+		return "must be one of " + strings.ReplaceAll(fieldErr.Param(), " ", ", ")
+	case "min":
+		return "must be at least " + fieldErr.Param()
+	case "max":
+		return "must be at most " + fieldErr.Param()
+	case "gt":
+		return "must be greater than " + fieldErr.Param()
+	}
+	return fmt.Sprintf("is invalid (%s)", fieldErr.Tag())
+}
+
+// Print writes the configuration in the config file format, with the secrets redacted.
+func (envVar *Config) Print(w io.Writer) error {
+	value := reflect.ValueOf(envVar).Elem()
+	for _, f := range fields {
+		fieldValue := value.Field(f.index).Interface()
+		if duration, ok := fieldValue.(time.Duration); ok {
+			fieldValue = duration.String()
+		}
+		if f.secret && !value.Field(f.index).IsZero() {
+			fieldValue = redacted
+		}
+		line, err := yaml.Marshal(map[string]any{f.key: fieldValue})
+		if err != nil {
+			return err
+		}
+		if _, err = w.Write(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
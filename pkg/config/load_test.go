@@ -0,0 +1,116 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	yamlFile := filepath.Join(dir, "mockva.yaml")
+	require.NoError(t, os.WriteFile(yamlFile, []byte("postgres_host: db.yaml\npostgres_username: mockva\nport: 9090\nsettlement_delay: 10s\nasync_transfer: true\n"), 0o600))
+	tomlFile := filepath.Join(dir, "mockva.toml")
+	require.NoError(t, os.WriteFile(tomlFile, []byte("postgres_host = \"db.toml\"\npostgres_username = \"mockva\"\ntracing_sample_ratio = 0.25\n"), 0o600))
+	unknownKeyFile := filepath.Join(dir, "unknown.yaml")
+	require.NoError(t, os.WriteFile(unknownKeyFile, []byte("postgres_hots: db\n"), 0o600))
+
+	testCases := []struct {
+		name          string
+		args          []string
+		env           map[string]string
+		assert        func(assertions *require.Assertions, cfg *Config)
+		expectedError string
+	}{
+		{name: "Defaults and env", env: map[string]string{"POSTGRES_HOST": "db.env", "POSTGRES_USERNAME": "mockva", "RATE_LIMIT": ""},
+			assert: func(assertions *require.Assertions, cfg *Config) {
+				assertions.Equal("db.env", cfg.PostgresHost)
+				assertions.Equal(8080, cfg.Port)
+				assertions.Equal(5432, cfg.PostgresPort)
+				assertions.Equal(5*time.Second, cfg.SettlementDelay)
+				assertions.Equal("ulid", cfg.IDGenerator)
+			}},
+		{name: "YAML file", args: []string{"--config", yamlFile},
+			assert: func(assertions *require.Assertions, cfg *Config) {
+				assertions.Equal("db.yaml", cfg.PostgresHost)
+				assertions.Equal(9090, cfg.Port)
+				assertions.Equal(10*time.Second, cfg.SettlementDelay)
+				assertions.True(cfg.AsyncTransfer)
+			}},
+		{name: "TOML file from env", env: map[string]string{EnvConfigFile: tomlFile},
+			assert: func(assertions *require.Assertions, cfg *Config) {
+				assertions.Equal("db.toml", cfg.PostgresHost)
+				assertions.Equal(0.25, cfg.TracingSampleRatio)
+			}},
+		{name: "Env overrides file, flags override env", args: []string{"--config", yamlFile, "--port", "7070", "--auth-enabled"},
+			env: map[string]string{"POSTGRES_HOST": "db.env", "PORT": "6060"},
+			assert: func(assertions *require.Assertions, cfg *Config) {
+				assertions.Equal("db.env", cfg.PostgresHost)
+				assertions.Equal(7070, cfg.Port)
+				assertions.True(cfg.AuthEnabled)
+				assertions.Equal(10*time.Second, cfg.SettlementDelay)
+			}},
+		{name: "Missing required values", expectedError: "POSTGRES_HOST is required\nPOSTGRES_USERNAME is required"},
+		{name: "Invalid values", args: []string{"--postgres-host", "db", "--postgres-username", "mockva", "--log-format", "xml", "--port", "0"},
+			expectedError: "PORT must be at least 1\nLOG_FORMAT must be one of text, json"},
+		{name: "Unparsable value", args: []string{"--postgres-host", "db", "--postgres-username", "mockva"}, env: map[string]string{"SETTLEMENT_DELAY": "soon"},
+			expectedError: `SETTLEMENT_DELAY: invalid value "soon" from SETTLEMENT_DELAY`},
+		{name: "Unknown file key", args: []string{"--config", unknownKeyFile}, expectedError: `unknown key "postgres_hots"`},
+		{name: "Unknown file format", args: []string{"--config", filepath.Join(dir, "mockva.json")}, expectedError: "must be .yaml, .yml or .toml"},
+		{name: "Unknown flag", args: []string{"--postgres-hots", "db"}, expectedError: "flag provided but not defined"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lookupEnv := func(key string) (string, bool) {
+				value, ok := tc.env[key]
+				return value, ok
+			}
+			cfg, printConfig, err := Load(tc.args, lookupEnv)
+
+			assertions := require.New(t)
+			assertions.False(printConfig)
+			if tc.expectedError != "" {
+				assertions.ErrorContains(err, tc.expectedError)
+				return
+			}
+			assertions.Nil(err)
+			tc.assert(assertions, cfg)
+		})
+	}
+}
+
+func TestConfig_Print(t *testing.T) {
+	cfg, printConfig, err := Load([]string{"--print-config", "--postgres-host", "db", "--postgres-username", "mockva",
+		"--postgres-password", "hunter2"}, func(string) (string, bool) { return "", false })
+
+	assertions := require.New(t)
+	assertions.Nil(err)
+	assertions.True(printConfig)
+
+	var output bytes.Buffer
+	assertions.Nil(cfg.Print(&output))
+	assertions.Contains(output.String(), "postgres_host: db\n")
+	assertions.Contains(output.String(), "postgres_password: '******'\n")
+	assertions.Contains(output.String(), "admin_api_key: \"\"\n")
+	assertions.Contains(output.String(), "settlement_delay: 5s\n")
+	assertions.NotContains(output.String(), "hunter2")
+
+	printed := filepath.Join(t.TempDir(), "printed.yaml")
+	assertions.Nil(os.WriteFile(printed, output.Bytes(), 0o600))
+	reloaded, _, err := Load([]string{"--config", printed, "--postgres-password", "hunter2"}, func(string) (string, bool) { return "", false })
+	assertions.Nil(err)
+	assertions.Equal(cfg, reloaded)
+}
+
+func TestConfig_PostgresDSN(t *testing.T) {
+	cfg, _, err := Load([]string{"--postgres-host", "db", "--postgres-username", "mockva", "--postgres-password", `it's a \secret`,
+		"--postgres-lock-timeout", "1500ms", "--postgres-connect-timeout", "0s"}, func(string) (string, bool) { return "", false })
+	require.NoError(t, err)
+
+	require.Equal(t, `host='db' port='5432' user='mockva' password='it\'s a \\secret' dbname='mockva' sslmode='prefer' `+
+		`connect_timeout='0' application_name='mockva' statement_timeout='30000' lock_timeout='1500'`, cfg.PostgresDSN())
+}
@@ -2,10 +2,11 @@ package controller
 
 import (
 	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/logging"
 	"github.com/mrth1995/go-mockva/pkg/model"
 	"github.com/mrth1995/go-mockva/pkg/server/responseWriter"
 	"github.com/mrth1995/go-mockva/pkg/service"
-	"github.com/sirupsen/logrus"
+	"github.com/mrth1995/go-mockva/pkg/validation"
 )
 
 type AccountController struct {
@@ -24,8 +25,8 @@ func (accountController *AccountController) FindByUserID(request *restful.Reques
 	accountID := request.PathParameter("accountId")
 	existingAccount, err := accountController.AccountService.FindByID(ctx, accountID)
 	if existingAccount == nil && err != nil {
-		logrus.Infof("Account %v not found", accountID)
-		responseWriter.WriteBadRequest(err, response)
+		logging.FromContext(ctx).Infof("Account %v not found", accountID)
+		responseWriter.WriteError(err, request, response)
 		return
 	}
 	responseWriter.WriteOK(existingAccount, response)
@@ -37,14 +38,18 @@ func (accountController *AccountController) CreateAccount(request *restful.Reque
 	var accountRegister model.AccountRegister
 	err := request.ReadEntity(&accountRegister)
 	if err != nil {
-		logrus.Error(err)
-		responseWriter.WriteBadRequest(err, response)
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteBadRequest(err, request, response)
+		return
+	}
+	if err = validation.Struct(&accountRegister); err != nil {
+		responseWriter.WriteError(err, request, response)
 		return
 	}
 	newAccount, err := accountController.AccountService.Register(ctx, &accountRegister)
 	if err != nil {
-		logrus.Error(err)
-		responseWriter.WriteBadRequest(err, response)
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteError(err, request, response)
 		return
 	}
 	responseWriter.WriteOK(newAccount, response)
@@ -56,16 +61,20 @@ func (accountController *AccountController) EditAccount(request *restful.Request
 	accountID := request.PathParameter("accountId")
 
 	var accountEdit model.AccountEdit
-	err := request.ReadEntity(accountEdit)
+	err := request.ReadEntity(&accountEdit)
 	if err != nil {
-		logrus.Error(err)
-		responseWriter.WriteBadRequest(err, response)
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteBadRequest(err, request, response)
+		return
+	}
+	if err = validation.Struct(&accountEdit); err != nil {
+		responseWriter.WriteError(err, request, response)
 		return
 	}
 	account, err := accountController.AccountService.Edit(ctx, accountID, &accountEdit)
 	if err != nil {
-		logrus.Error(err)
-		responseWriter.WriteBadRequest(err, response)
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteError(err, request, response)
 		return
 	}
 	responseWriter.WriteOK(account, response)
@@ -5,6 +5,7 @@ import (
 
 	restfulspec "github.com/emicklei/go-restful-openapi/v2"
 	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/auth"
 	endpointError "github.com/mrth1995/go-mockva/pkg/errors"
 	"github.com/mrth1995/go-mockva/pkg/model"
 )
@@ -14,35 +15,38 @@ func (accountController *AccountController) RegisterEndpoint(ws *restful.WebServ
 	ws.Route(
 		ws.GET("/accounts/{accountId}").
 			To(accountController.FindByUserID).
-			Produces(restful.MIME_JSON).
+			Produces(restful.MIME_JSON, endpointError.ProblemMediaType).
 			Param(restful.PathParameter("accountId", "Account ID")).
 			Returns(http.StatusOK, "Account exist", model.AccountInfo{}).
 			Returns(http.StatusBadRequest, "Validation error", endpointError.EndpointError{}).
 			Returns(http.StatusNotFound, "Account not found", endpointError.EndpointError{}).
 			Returns(http.StatusInternalServerError, "Internal server error", endpointError.EndpointError{}).
-			Metadata(restfulspec.KeyOpenAPITags, tags),
+			Metadata(restfulspec.KeyOpenAPITags, tags).
+			Metadata(auth.RouteScopesKey, []string{auth.ScopeAccountsRead}),
 	)
 	ws.Route(
 		ws.POST("/accounts").
 			To(accountController.CreateAccount).
 			Consumes(restful.MIME_JSON).
-			Produces(restful.MIME_JSON).
+			Produces(restful.MIME_JSON, endpointError.ProblemMediaType).
 			Reads(model.AccountRegister{}).
 			Returns(http.StatusOK, "Account successfully created", model.AccountInfo{}).
 			Returns(http.StatusBadRequest, "Validation error", endpointError.EndpointError{}).
 			Returns(http.StatusConflict, "Account already exist", endpointError.EndpointError{}).
 			Returns(http.StatusInternalServerError, "Internal server error", endpointError.EndpointError{}).
-			Metadata(restfulspec.KeyOpenAPITags, tags))
+			Metadata(restfulspec.KeyOpenAPITags, tags).
+			Metadata(auth.RouteScopesKey, []string{auth.ScopeAccountsWrite}))
 
 	ws.Route(
 		ws.PATCH("/accounts/{accountId}").
 			To(accountController.EditAccount).
 			Consumes(restful.MIME_JSON).
-			Produces(restful.MIME_JSON).
+			Produces(restful.MIME_JSON, endpointError.ProblemMediaType).
 			Reads(model.AccountEdit{}).
 			Returns(http.StatusOK, "Account successfully UPDATED", model.AccountInfo{}).
 			Returns(http.StatusBadRequest, "Validation error", endpointError.EndpointError{}).
-			Returns(http.StatusNotFound, "Account not exist", endpointError.EndpointError{}).
+			Returns(http.StatusNotFound, "Account not found", endpointError.EndpointError{}).
 			Returns(http.StatusInternalServerError, "Internal server error", endpointError.EndpointError{}).
-			Metadata(restfulspec.KeyOpenAPITags, tags))
+			Metadata(restfulspec.KeyOpenAPITags, tags).
+			Metadata(auth.RouteScopesKey, []string{auth.ScopeAccountsWrite}))
 }
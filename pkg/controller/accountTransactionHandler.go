@@ -2,13 +2,23 @@
 package controller
 
 import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	"github.com/mrth1995/go-mockva/pkg/logging"
 	"github.com/mrth1995/go-mockva/pkg/model"
 	"github.com/mrth1995/go-mockva/pkg/server/responseWriter"
 	"github.com/mrth1995/go-mockva/pkg/service"
-	"github.com/sirupsen/logrus"
+	"github.com/mrth1995/go-mockva/pkg/validation"
 )
 
+const metadataQueryPrefix = "metadata."
+
 type AccountTransactionController struct {
 	AccountTransactionService *service.AccountTransactionService
 }
@@ -24,15 +34,182 @@ func (accountTransactionController *AccountTransactionController) Transfer(reque
 	var param model.AccountFundTransfer
 	err := request.ReadEntity(&param)
 	if err != nil {
-		logrus.Error(err)
-		responseWriter.WriteBadRequest(err, response)
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteBadRequest(err, request, response)
+		return
+	}
+	if err = validation.Struct(&param); err != nil {
+		responseWriter.WriteError(err, request, response)
 		return
 	}
 	trx, err := accountTransactionController.AccountTransactionService.Transfer(ctx, &param)
 	if err != nil {
-		logrus.Error(err)
-		responseWriter.WriteBadRequest(err, response)
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteError(err, request, response)
+		return
+	}
+	accountTransactionController.writeTransaction(ctx, trx, request, response)
+}
+
+// FindByID get transaction and its current status
+func (accountTransactionController *AccountTransactionController) FindByID(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+
+	transactionID := request.PathParameter("transactionId")
+	trxInfo, err := accountTransactionController.AccountTransactionService.FindInfoByID(ctx, transactionID)
+	if err != nil {
+		logging.FromContext(ctx).Infof("Transaction %v not found", transactionID)
+		responseWriter.WriteError(err, request, response)
+		return
+	}
+	responseWriter.WriteOK(trxInfo, response)
+}
+
+// Search get transaction history matching the query parameters
+func (accountTransactionController *AccountTransactionController) Search(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+
+	search, err := parseTransactionSearch(request)
+	if err != nil {
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteBadRequest(err, request, response)
+		return
+	}
+	trxPage, err := accountTransactionController.AccountTransactionService.Search(ctx, search)
+	if err != nil {
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteError(err, request, response)
+		return
+	}
+	responseWriter.WriteOK(trxPage, response)
+}
+
+// Reverse return the funds of a successful transaction to its source account
+func (accountTransactionController *AccountTransactionController) Reverse(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+
+	transactionID := request.PathParameter("transactionId")
+	trx, err := accountTransactionController.AccountTransactionService.Reverse(ctx, transactionID)
+	if err != nil {
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteError(err, request, response)
 		return
 	}
-	responseWriter.WriteOK(trx, response)
+	accountTransactionController.writeTransaction(ctx, trx, request, response)
+}
+
+// Deposit credit account balance with funds coming from an external channel
+func (accountTransactionController *AccountTransactionController) Deposit(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+
+	var param model.AccountDeposit
+	err := request.ReadEntity(&param)
+	if err != nil {
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteBadRequest(err, request, response)
+		return
+	}
+	trx, err := accountTransactionController.AccountTransactionService.Deposit(ctx, &param)
+	if err != nil {
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteError(err, request, response)
+		return
+	}
+	accountTransactionController.writeTransaction(ctx, trx, request, response)
+}
+
+// Withdraw debit account balance and move the funds out to an external channel
+func (accountTransactionController *AccountTransactionController) Withdraw(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+
+	var param model.AccountWithdrawal
+	err := request.ReadEntity(&param)
+	if err != nil {
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteBadRequest(err, request, response)
+		return
+	}
+	trx, err := accountTransactionController.AccountTransactionService.Withdraw(ctx, &param)
+	if err != nil {
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteError(err, request, response)
+		return
+	}
+	accountTransactionController.writeTransaction(ctx, trx, request, response)
+}
+
+// writeTransaction write the transaction with its account names resolved, a PENDING transaction is written as accepted
+func (accountTransactionController *AccountTransactionController) writeTransaction(ctx context.Context, trx *domain.AccountTransaction, request *restful.Request, response *restful.Response) {
+	trxInfo, err := accountTransactionController.AccountTransactionService.ToInfo(ctx, trx)
+	if err != nil {
+		logging.FromContext(request.Request.Context()).Error(err)
+		responseWriter.WriteError(err, request, response)
+		return
+	}
+	if trx.Status == domain.TransactionStatusPending {
+		responseWriter.WriteAccepted(trxInfo, response)
+		return
+	}
+	responseWriter.WriteOK(trxInfo, response)
+}
+
+// parseTransactionSearch read the search criteria from the query parameters, metadata is given as metadata.<key>=<value>
+func parseTransactionSearch(request *restful.Request) (*model.AccountTransactionSearch, error) {
+	search := &model.AccountTransactionSearch{
+		AccountID:         request.QueryParameter("accountId"),
+		ExternalReference: request.QueryParameter("externalReference"),
+		PurposeCode:       request.QueryParameter("purposeCode"),
+		Remark:            request.QueryParameter("remark"),
+		Status:            request.QueryParameter("status"),
+		Type:              request.QueryParameter("type"),
+		ClientID:          request.QueryParameter("clientId"),
+	}
+	for key, values := range request.Request.URL.Query() {
+		metadataKey, ok := strings.CutPrefix(key, metadataQueryPrefix)
+		if !ok || metadataKey == "" || len(values) == 0 {
+			continue
+		}
+		if search.Metadata == nil {
+			search.Metadata = map[string]string{}
+		}
+		search.Metadata[metadataKey] = values[0]
+	}
+	var err error
+	if search.From, err = parseTimeQuery(request, "from"); err != nil {
+		return nil, err
+	}
+	if search.To, err = parseTimeQuery(request, "to"); err != nil {
+		return nil, err
+	}
+	if search.Page, err = parseIntQuery(request, "page"); err != nil {
+		return nil, err
+	}
+	if search.Size, err = parseIntQuery(request, "size"); err != nil {
+		return nil, err
+	}
+	return search, nil
+}
+
+func parseTimeQuery(request *restful.Request, name string) (*time.Time, error) {
+	value := request.QueryParameter(name)
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s format %v", name, value)
+	}
+	return &t, nil
+}
+
+func parseIntQuery(request *restful.Request, name string) (int, error) {
+	value := request.QueryParameter(name)
+	if value == "" {
+		return 0, nil
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %v", name, value)
+	}
+	return i, nil
 }
@@ -5,6 +5,7 @@ import (
 
 	restfulspec "github.com/emicklei/go-restful-openapi/v2"
 	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/auth"
 	endpointError "github.com/mrth1995/go-mockva/pkg/errors"
 	"github.com/mrth1995/go-mockva/pkg/model"
 )
@@ -15,10 +16,81 @@ func (accountTransactionController *AccountTransactionController) RegisterEndpoi
 		ws.POST("/accountTransactions/transfer").
 			To(accountTransactionController.Transfer).
 			Consumes(restful.MIME_JSON).
-			Produces(restful.MIME_JSON).
+			Produces(restful.MIME_JSON, endpointError.ProblemMediaType).
 			Reads(model.AccountFundTransfer{}).
 			Returns(http.StatusOK, "Transaction success", model.AccountTransactionInfo{}).
+			Returns(http.StatusAccepted, "Transaction accepted and pending settlement", model.AccountTransactionInfo{}).
 			Returns(http.StatusBadRequest, "Validation error", endpointError.EndpointError{}).
+			Returns(http.StatusNotFound, "Account not found", endpointError.EndpointError{}).
+			Returns(http.StatusUnprocessableEntity, "Insufficient funds", endpointError.EndpointError{}).
 			Returns(http.StatusInternalServerError, "Internal server error", endpointError.EndpointError{}).
-			Metadata(restfulspec.KeyOpenAPITags, tags))
+			Metadata(restfulspec.KeyOpenAPITags, tags).
+			Metadata(auth.RouteScopesKey, []string{auth.ScopeTransfersWrite}))
+	ws.Route(
+		ws.POST("/accountTransactions/deposit").
+			To(accountTransactionController.Deposit).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON, endpointError.ProblemMediaType).
+			Reads(model.AccountDeposit{}).
+			Returns(http.StatusOK, "Deposit success", model.AccountTransactionInfo{}).
+			Returns(http.StatusBadRequest, "Validation error", endpointError.EndpointError{}).
+			Returns(http.StatusNotFound, "Account not found", endpointError.EndpointError{}).
+			Returns(http.StatusInternalServerError, "Internal server error", endpointError.EndpointError{}).
+			Metadata(restfulspec.KeyOpenAPITags, tags).
+			Metadata(auth.RouteScopesKey, []string{auth.ScopeTransfersWrite}))
+	ws.Route(
+		ws.POST("/accountTransactions/withdrawal").
+			To(accountTransactionController.Withdraw).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON, endpointError.ProblemMediaType).
+			Reads(model.AccountWithdrawal{}).
+			Returns(http.StatusOK, "Withdrawal success", model.AccountTransactionInfo{}).
+			Returns(http.StatusBadRequest, "Validation error", endpointError.EndpointError{}).
+			Returns(http.StatusNotFound, "Account not found", endpointError.EndpointError{}).
+			Returns(http.StatusUnprocessableEntity, "Insufficient funds", endpointError.EndpointError{}).
+			Returns(http.StatusInternalServerError, "Internal server error", endpointError.EndpointError{}).
+			Metadata(restfulspec.KeyOpenAPITags, tags).
+			Metadata(auth.RouteScopesKey, []string{auth.ScopeTransfersWrite}))
+	ws.Route(
+		ws.GET("/accountTransactions").
+			To(accountTransactionController.Search).
+			Produces(restful.MIME_JSON, endpointError.ProblemMediaType).
+			Param(restful.QueryParameter("accountId", "Source or destination account ID")).
+			Param(restful.QueryParameter("externalReference", "Client external reference")).
+			Param(restful.QueryParameter("purposeCode", "Transfer purpose code")).
+			Param(restful.QueryParameter("remark", "Text contained in the remark")).
+			Param(restful.QueryParameter("status", "Transaction status").PossibleValues([]string{"PENDING", "SUCCESS", "FAILED", "REVERSED"})).
+			Param(restful.QueryParameter("type", "Transaction type").PossibleValues([]string{"TRANSFER", "DEPOSIT", "WITHDRAWAL"})).
+			Param(restful.QueryParameter("clientId", "ID of the API client that created the transaction")).
+			Param(restful.QueryParameter("metadata.{key}", "Metadata value, repeatable for any metadata key")).
+			Param(restful.QueryParameter("from", "Inclusive lower bound of transaction timestamp (RFC3339)")).
+			Param(restful.QueryParameter("to", "Exclusive upper bound of transaction timestamp (RFC3339)")).
+			Param(restful.QueryParameter("page", "Page number starting from 1").DataType("integer")).
+			Param(restful.QueryParameter("size", "Page size, maximum 100").DataType("integer")).
+			Returns(http.StatusOK, "Transaction history", model.AccountTransactionPage{}).
+			Returns(http.StatusBadRequest, "Validation error", endpointError.EndpointError{}).
+			Returns(http.StatusInternalServerError, "Internal server error", endpointError.EndpointError{}).
+			Metadata(restfulspec.KeyOpenAPITags, tags).
+			Metadata(auth.RouteScopesKey, []string{auth.ScopeTransfersRead}))
+	ws.Route(
+		ws.GET("/accountTransactions/{transactionId}").
+			To(accountTransactionController.FindByID).
+			Produces(restful.MIME_JSON, endpointError.ProblemMediaType).
+			Param(restful.PathParameter("transactionId", "Transaction ID")).
+			Returns(http.StatusOK, "Transaction exist", model.AccountTransactionInfo{}).
+			Returns(http.StatusNotFound, "Transaction not found", endpointError.EndpointError{}).
+			Returns(http.StatusInternalServerError, "Internal server error", endpointError.EndpointError{}).
+			Metadata(restfulspec.KeyOpenAPITags, tags).
+			Metadata(auth.RouteScopesKey, []string{auth.ScopeTransfersRead}))
+	ws.Route(
+		ws.POST("/accountTransactions/{transactionId}/reverse").
+			To(accountTransactionController.Reverse).
+			Produces(restful.MIME_JSON, endpointError.ProblemMediaType).
+			Param(restful.PathParameter("transactionId", "Transaction ID")).
+			Returns(http.StatusOK, "Transaction reversed", model.AccountTransactionInfo{}).
+			Returns(http.StatusNotFound, "Transaction not found", endpointError.EndpointError{}).
+			Returns(http.StatusConflict, "Transaction cannot be reversed", endpointError.EndpointError{}).
+			Returns(http.StatusInternalServerError, "Internal server error", endpointError.EndpointError{}).
+			Metadata(restfulspec.KeyOpenAPITags, tags).
+			Metadata(auth.RouteScopesKey, []string{auth.ScopeTransfersWrite}))
 }
@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/logging"
+	"github.com/mrth1995/go-mockva/pkg/model"
+	"github.com/mrth1995/go-mockva/pkg/server/responseWriter"
+	"github.com/mrth1995/go-mockva/pkg/service"
+	"github.com/mrth1995/go-mockva/pkg/validation"
+)
+
+type APIClientController struct {
+	APIClientService *service.APIClientService
+}
+
+func NewAPIClientController(apiClientService *service.APIClientService) *APIClientController {
+	return &APIClientController{APIClientService: apiClientService}
+}
+
+// Create register an API client and return its API secret
+func (apiClientController *APIClientController) Create(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+
+	var param model.APIClientRegister
+	err := request.ReadEntity(&param)
+	if err != nil {
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteBadRequest(err, request, response)
+		return
+	}
+	if err = validation.Struct(&param); err != nil {
+		responseWriter.WriteError(err, request, response)
+		return
+	}
+	client, secret, err := apiClientController.APIClientService.Create(ctx, &param)
+	if err != nil {
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteError(err, request, response)
+		return
+	}
+	info := apiClientController.APIClientService.ToInfo(client)
+	info.APISecret = secret
+	responseWriter.WriteOK(info, response)
+}
+
+// Edit update the keys and access rules of an API client
+func (apiClientController *APIClientController) Edit(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+
+	var param model.APIClientEdit
+	err := request.ReadEntity(&param)
+	if err != nil {
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteBadRequest(err, request, response)
+		return
+	}
+	if err = validation.Struct(&param); err != nil {
+		responseWriter.WriteError(err, request, response)
+		return
+	}
+	client, err := apiClientController.APIClientService.Edit(ctx, request.PathParameter("clientId"), &param)
+	if err != nil {
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteError(err, request, response)
+		return
+	}
+	responseWriter.WriteOK(apiClientController.APIClientService.ToInfo(client), response)
+}
+
+// RotateSecret replace the API secret of an API client and return the new one
+func (apiClientController *APIClientController) RotateSecret(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+
+	client, secret, err := apiClientController.APIClientService.RotateSecret(ctx, request.PathParameter("clientId"))
+	if err != nil {
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteError(err, request, response)
+		return
+	}
+	info := apiClientController.APIClientService.ToInfo(client)
+	info.APISecret = secret
+	responseWriter.WriteOK(info, response)
+}
+
+// FindAll get every API client without their secrets
+func (apiClientController *APIClientController) FindAll(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+
+	clients, err := apiClientController.APIClientService.FindAll(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteError(err, request, response)
+		return
+	}
+	infos := make([]*model.APIClientInfo, 0, len(clients))
+	for i := range clients {
+		infos = append(infos, apiClientController.APIClientService.ToInfo(&clients[i]))
+	}
+	responseWriter.WriteOK(infos, response)
+}
+
+// FindByID get an API client without its secrets
+func (apiClientController *APIClientController) FindByID(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+
+	clientID := request.PathParameter("clientId")
+	client, err := apiClientController.APIClientService.FindByID(ctx, clientID)
+	if err != nil {
+		logging.FromContext(ctx).Infof("API client %v not found", clientID)
+		responseWriter.WriteError(err, request, response)
+		return
+	}
+	responseWriter.WriteOK(apiClientController.APIClientService.ToInfo(client), response)
+}
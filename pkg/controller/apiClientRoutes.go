@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"net/http"
+
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	endpointError "github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/model"
+)
+
+func (apiClientController *APIClientController) RegisterEndpoint(ws *restful.WebService) {
+	tags := []string{"API Clients"}
+	ws.Route(
+		ws.POST("/apiClients").
+			To(apiClientController.Create).
+			Doc("Register an API client and generate its API secret").
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON, endpointError.ProblemMediaType).
+			Reads(model.APIClientRegister{}).
+			Returns(http.StatusOK, "API client registered, the API secret is only returned once", model.APIClientInfo{}).
+			Returns(http.StatusBadRequest, "Validation error", endpointError.EndpointError{}).
+			Returns(http.StatusConflict, "API client already exist", endpointError.EndpointError{}).
+			Returns(http.StatusInternalServerError, "Internal server error", endpointError.EndpointError{}).
+			Metadata(restfulspec.KeyOpenAPITags, tags).
+			Metadata(auth.RouteAdminKey, true))
+	ws.Route(
+		ws.GET("/apiClients").
+			To(apiClientController.FindAll).
+			Produces(restful.MIME_JSON, endpointError.ProblemMediaType).
+			Returns(http.StatusOK, "API clients", []model.APIClientInfo{}).
+			Returns(http.StatusInternalServerError, "Internal server error", endpointError.EndpointError{}).
+			Metadata(restfulspec.KeyOpenAPITags, tags).
+			Metadata(auth.RouteAdminKey, true))
+	ws.Route(
+		ws.GET("/apiClients/{clientId}").
+			To(apiClientController.FindByID).
+			Produces(restful.MIME_JSON, endpointError.ProblemMediaType).
+			Param(restful.PathParameter("clientId", "Client ID")).
+			Returns(http.StatusOK, "API client exist", model.APIClientInfo{}).
+			Returns(http.StatusNotFound, "API client not found", endpointError.EndpointError{}).
+			Returns(http.StatusInternalServerError, "Internal server error", endpointError.EndpointError{}).
+			Metadata(restfulspec.KeyOpenAPITags, tags).
+			Metadata(auth.RouteAdminKey, true))
+	ws.Route(
+		ws.PATCH("/apiClients/{clientId}").
+			To(apiClientController.Edit).
+			Doc("Edit the keys, scopes and access rules of an API client").
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON, endpointError.ProblemMediaType).
+			Param(restful.PathParameter("clientId", "Client ID")).
+			Reads(model.APIClientEdit{}).
+			Returns(http.StatusOK, "API client updated", model.APIClientInfo{}).
+			Returns(http.StatusBadRequest, "Validation error", endpointError.EndpointError{}).
+			Returns(http.StatusNotFound, "API client not found", endpointError.EndpointError{}).
+			Returns(http.StatusInternalServerError, "Internal server error", endpointError.EndpointError{}).
+			Metadata(restfulspec.KeyOpenAPITags, tags).
+			Metadata(auth.RouteAdminKey, true))
+	ws.Route(
+		ws.POST("/apiClients/{clientId}/secret").
+			To(apiClientController.RotateSecret).
+			Doc("Generate a new API secret, the previous one stops working").
+			Produces(restful.MIME_JSON, endpointError.ProblemMediaType).
+			Param(restful.PathParameter("clientId", "Client ID")).
+			Returns(http.StatusOK, "API secret rotated, the API secret is only returned once", model.APIClientInfo{}).
+			Returns(http.StatusNotFound, "API client not found", endpointError.EndpointError{}).
+			Returns(http.StatusInternalServerError, "Internal server error", endpointError.EndpointError{}).
+			Metadata(restfulspec.KeyOpenAPITags, tags).
+			Metadata(auth.RouteAdminKey, true))
+}
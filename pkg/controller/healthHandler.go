@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"net/http"
+
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/health"
+)
+
+type HealthController struct {
+	Checker *health.Checker
+}
+
+func NewHealthController(checker *health.Checker) *HealthController {
+	return &HealthController{Checker: checker}
+}
+
+// Liveness tell the process is alive, without checking its dependencies
+func (healthController *HealthController) Liveness(request *restful.Request, response *restful.Response) {
+	_ = response.WriteHeaderAndEntity(http.StatusOK, health.Report{Status: health.StatusUp})
+}
+
+// Readiness check every dependency and tell whether traffic may be routed to the process
+func (healthController *HealthController) Readiness(request *restful.Request, response *restful.Response) {
+	report := healthController.Checker.Readiness(request.Request.Context())
+	status := http.StatusOK
+	if !report.Ready() {
+		status = http.StatusServiceUnavailable
+	}
+	_ = response.WriteHeaderAndEntity(status, report)
+}
+
+func (healthController *HealthController) RegisterEndpoint(ws *restful.WebService) {
+	tags := []string{"Health"}
+	ws.Route(ws.GET("/healthz").
+		To(healthController.Liveness).
+		Doc("Liveness probe, OK while the process is alive").
+		Produces(restful.MIME_JSON).
+		Returns(http.StatusOK, "Alive", health.Report{}).
+		Metadata(restfulspec.KeyOpenAPITags, tags))
+	ws.Route(ws.GET("/readyz").
+		To(healthController.Readiness).
+		Doc("Readiness probe, checking the database, its schema version and the background workers").
+		Produces(restful.MIME_JSON).
+		Returns(http.StatusOK, "Ready", health.Report{}).
+		Returns(http.StatusServiceUnavailable, "Not ready or shutting down", health.Report{}).
+		Metadata(restfulspec.KeyOpenAPITags, tags))
+}
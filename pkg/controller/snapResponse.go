@@ -0,0 +1,187 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	endpointError "github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/model"
+	"github.com/mrth1995/go-mockva/pkg/server/responseWriter"
+)
+
+// SNAP service codes, the middle two digits of every SNAP responseCode.
+const (
+	snapServiceAccessToken   = "73"
+	snapServiceInquiry       = "24"
+	snapServicePayment       = "25"
+	snapServiceInquiryStatus = "26"
+	snapServiceCreateVA      = "27"
+	snapServiceUpdateVA      = "28"
+	snapServiceDeleteVA      = "31"
+)
+
+// SnapServiceCodeKey is the route metadata key holding the SNAP service code of the route.
+const SnapServiceCodeKey = "snap.serviceCode"
+
+// Headers every SNAP service request must carry.
+const (
+	SnapHeaderTimestamp  = "X-TIMESTAMP"
+	SnapHeaderSignature  = "X-SIGNATURE"
+	SnapHeaderPartnerID  = "X-PARTNER-ID"
+	SnapHeaderClientKey  = "X-CLIENT-KEY"
+	SnapHeaderExternalID = "X-EXTERNAL-ID"
+	SnapHeaderChannelID  = "CHANNEL-ID"
+)
+
+var snapRequiredHeaders = []string{
+	SnapHeaderTimestamp,
+	SnapHeaderSignature,
+	SnapHeaderPartnerID,
+	SnapHeaderExternalID,
+	SnapHeaderChannelID,
+}
+
+// snapError is a SNAP failure: the HTTP status and case code that make up the responseCode,
+// and its responseMessage.
+type snapError struct {
+	httpStatus int
+	caseCode   string
+	message    string
+}
+
+func (e *snapError) Error() string {
+	return e.message
+}
+
+func invalidFieldFormat(field string) *snapError {
+	return &snapError{httpStatus: http.StatusBadRequest, caseCode: "01", message: "Invalid Field Format " + field}
+}
+
+func invalidMandatoryField(field string) *snapError {
+	return &snapError{httpStatus: http.StatusBadRequest, caseCode: "02", message: "Invalid Mandatory Field " + field}
+}
+
+// toSnapError maps a mockva error to its SNAP response code.
+func toSnapError(e error) *snapError {
+	if oauthErr, ok := e.(*auth.OAuthError); ok {
+		return &snapError{httpStatus: http.StatusUnauthorized, caseCode: "00", message: "Unauthorized. " + oauthErr.Description}
+	}
+	err := endpointError.From(e)
+	switch err.ErrorCode {
+	case endpointError.CodeValidation:
+		if len(err.Errors) > 0 {
+			fieldErr := err.Errors[0]
+			if fieldErr.Message == "is required" {
+				return invalidMandatoryField(fieldErr.Field)
+			}
+			return invalidFieldFormat(fieldErr.Field)
+		}
+		return &snapError{httpStatus: http.StatusBadRequest, caseCode: "00", message: "Bad Request. " + err.ErrorMessage}
+	case endpointError.CodeVirtualAccountNotFound, endpointError.CodeAccountNotFound:
+		return &snapError{httpStatus: http.StatusNotFound, caseCode: "12", message: "Invalid Bill/Virtual Account"}
+	case endpointError.CodeInvalidAmount:
+		return &snapError{httpStatus: http.StatusNotFound, caseCode: "13", message: "Invalid Amount"}
+	case endpointError.CodeBillAlreadyPaid:
+		return &snapError{httpStatus: http.StatusNotFound, caseCode: "14", message: "Paid Bill"}
+	case endpointError.CodeVirtualAccountExpired:
+		return &snapError{httpStatus: http.StatusNotFound, caseCode: "19", message: "Invalid Bill/Virtual Account [Expired]"}
+	case endpointError.CodeTransactionNotFound:
+		return &snapError{httpStatus: http.StatusNotFound, caseCode: "01", message: "Transaction Not Found"}
+	case endpointError.CodeForbidden:
+		return &snapError{httpStatus: http.StatusForbidden, caseCode: "15", message: "Transaction Not Permitted. " + err.ErrorMessage}
+	case endpointError.CodeUnauthorized:
+		return &snapError{httpStatus: http.StatusUnauthorized, caseCode: "00", message: "Unauthorized. " + err.ErrorMessage}
+	case endpointError.CodeConflict:
+		return &snapError{httpStatus: http.StatusConflict, caseCode: "00", message: "Conflict"}
+	case endpointError.CodeTimeout:
+		return &snapError{httpStatus: http.StatusGatewayTimeout, caseCode: "00", message: "Timeout"}
+	default:
+		return &snapError{httpStatus: http.StatusInternalServerError, caseCode: "00", message: "General Error"}
+	}
+}
+
+// snapResponseCode builds a SNAP responseCode: HTTP status, service code and case code.
+func snapResponseCode(httpStatus int, serviceCode, caseCode string) string {
+	return strconv.Itoa(httpStatus) + serviceCode + caseCode
+}
+
+func writeSnapOK(serviceCode string, data any, response *restful.Response) {
+	responseWriter.WriteStatus(http.StatusOK, model.SnapResponse{
+		ResponseCode:       snapResponseCode(http.StatusOK, serviceCode, "00"),
+		ResponseMessage:    "Successful",
+		VirtualAccountData: data,
+	}, response)
+}
+
+func writeSnapError(serviceCode string, e error, response *restful.Response) {
+	snapErr, ok := e.(*snapError)
+	if !ok {
+		snapErr = toSnapError(e)
+	}
+	responseWriter.WriteStatus(snapErr.httpStatus, model.SnapResponse{
+		ResponseCode:    snapResponseCode(snapErr.httpStatus, serviceCode, snapErr.caseCode),
+		ResponseMessage: snapErr.message,
+	}, response)
+}
+
+// WriteSnapError writes e as a SNAP response, using the service code of the route that
+// matched the request. It lets filters reject SNAP requests in the SNAP format.
+func WriteSnapError(e error, request *restful.Request, response *restful.Response) {
+	serviceCode, _ := request.SelectedRoute().Metadata()[SnapServiceCodeKey].(string)
+	if serviceCode == "" {
+		serviceCode = "00"
+	}
+	writeSnapError(serviceCode, e, response)
+}
+
+// checkSnapHeaders verifies that the SNAP request headers are present and that X-TIMESTAMP is an
+// ISO 8601 timestamp. Signatures are not verified here.
+func checkSnapHeaders(request *restful.Request) error {
+	for _, header := range snapRequiredHeaders {
+		if strings.TrimSpace(request.HeaderParameter(header)) == "" {
+			return invalidMandatoryField(header)
+		}
+	}
+	if _, err := time.Parse(time.RFC3339, request.HeaderParameter(SnapHeaderTimestamp)); err != nil {
+		return invalidFieldFormat(SnapHeaderTimestamp)
+	}
+	return nil
+}
+
+func parseSnapAmount(field string, amount *model.SnapAmount) (float64, error) {
+	if amount == nil {
+		return 0, nil
+	}
+	value, err := strconv.ParseFloat(amount.Value, 64)
+	if err != nil {
+		return 0, invalidFieldFormat(field + ".value")
+	}
+	return value, nil
+}
+
+func toSnapAmount(value float64, currency string) model.SnapAmount {
+	return model.SnapAmount{Value: fmt.Sprintf("%.2f", value), Currency: currency}
+}
+
+func parseSnapTime(field, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, invalidFieldFormat(field)
+	}
+	return &t, nil
+}
+
+func formatSnapTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
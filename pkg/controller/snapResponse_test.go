@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+	endpointError "github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/model"
+	"github.com/mrth1995/go-mockva/pkg/validation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapResponseCode(t *testing.T) {
+	testCases := []struct {
+		name         string
+		err          error
+		responseCode string
+		message      string
+	}{
+		{
+			name:         "Missing mandatory field",
+			err:          validation.Struct(&model.SnapInquiryRequest{PartnerServiceID: "12345", CustomerNo: "002", VirtualAccountNo: "12345002"}),
+			responseCode: "4002402",
+			message:      "Invalid Mandatory Field inquiryRequestId",
+		},
+		{
+			name:         "Invalid nested field",
+			err:          validation.Struct(&model.SnapPaymentRequest{PartnerServiceID: "12345", CustomerNo: "002", VirtualAccountNo: "12345002", PaymentRequestID: "PAY-001", PaidAmount: &model.SnapAmount{Value: "1.00", Currency: "RUPIAH"}}),
+			responseCode: "4002401",
+			message:      "Invalid Field Format paidAmount.currency",
+		},
+		{
+			name:         "Virtual account not found",
+			err:          endpointError.NewVirtualAccountNotFound("12345002"),
+			responseCode: "4042412",
+			message:      "Invalid Bill/Virtual Account",
+		},
+		{
+			name:         "Paid bill",
+			err:          endpointError.NewBillAlreadyPaid("12345002"),
+			responseCode: "4042414",
+			message:      "Paid Bill",
+		},
+		{
+			name:         "Timeout",
+			err:          fmt.Errorf("query: %w", context.DeadlineExceeded),
+			responseCode: "5042400",
+			message:      "Timeout",
+		},
+		{
+			name:         "Unexpected error",
+			err:          http.ErrHandlerTimeout,
+			responseCode: "5002400",
+			message:      "General Error",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			snapErr := toSnapError(tc.err)
+			assertions := require.New(t)
+			assertions.Equal(tc.responseCode, snapResponseCode(snapErr.httpStatus, snapServiceInquiry, snapErr.caseCode))
+			assertions.Equal(tc.message, snapErr.message)
+		})
+	}
+}
+
+func TestCheckSnapHeaders(t *testing.T) {
+	newRequest := func(timestamp string) *restful.Request {
+		httpRequest := httptest.NewRequest(http.MethodPost, "/mockva/snap/v1.0/transfer-va/inquiry", nil)
+		httpRequest.Header.Set(SnapHeaderTimestamp, timestamp)
+		httpRequest.Header.Set(SnapHeaderSignature, "signature")
+		httpRequest.Header.Set(SnapHeaderPartnerID, "12345")
+		httpRequest.Header.Set(SnapHeaderExternalID, "41807553358950093184162180797837")
+		return restful.NewRequest(httpRequest)
+	}
+
+	assertions := require.New(t)
+	assertions.EqualError(checkSnapHeaders(newRequest("2024-01-01T10:00:00+07:00")), "Invalid Mandatory Field CHANNEL-ID")
+
+	request := newRequest("2024-01-01 10:00:00")
+	request.Request.Header.Set(SnapHeaderChannelID, "95221")
+	assertions.EqualError(checkSnapHeaders(request), "Invalid Field Format X-TIMESTAMP")
+
+	request = newRequest("2024-01-01T10:00:00+07:00")
+	request.Request.Header.Set(SnapHeaderChannelID, "95221")
+	assertions.NoError(checkSnapHeaders(request))
+}
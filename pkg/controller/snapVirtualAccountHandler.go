@@ -0,0 +1,215 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	"github.com/mrth1995/go-mockva/pkg/logging"
+	"github.com/mrth1995/go-mockva/pkg/model"
+	"github.com/mrth1995/go-mockva/pkg/service"
+	"github.com/mrth1995/go-mockva/pkg/validation"
+)
+
+const (
+	snapInquiryStatusSuccess = "00"
+	snapPaymentFlagSuccess   = "00"
+)
+
+var snapSuccessReason = model.SnapReason{English: "Success", Indonesia: "Sukses"}
+
+// SnapVirtualAccountController serves the SNAP BI transfer VA API.
+type SnapVirtualAccountController struct {
+	VirtualAccountService *service.VirtualAccountService
+}
+
+func NewSnapVirtualAccountController(virtualAccountService *service.VirtualAccountService) *SnapVirtualAccountController {
+	return &SnapVirtualAccountController{VirtualAccountService: virtualAccountService}
+}
+
+// CreateVA register a virtual account bill
+func (snapController *SnapVirtualAccountController) CreateVA(request *restful.Request, response *restful.Response) {
+	snapController.saveVA(snapServiceCreateVA, snapController.VirtualAccountService.Create, request, response)
+}
+
+// UpdateVA replace the bill details of an unpaid virtual account
+func (snapController *SnapVirtualAccountController) UpdateVA(request *restful.Request, response *restful.Response) {
+	snapController.saveVA(snapServiceUpdateVA, snapController.VirtualAccountService.Update, request, response)
+}
+
+func (snapController *SnapVirtualAccountController) saveVA(serviceCode string,
+	save func(ctx context.Context, register *model.VirtualAccountRegister) (*domain.VirtualAccount, error),
+	request *restful.Request, response *restful.Response) {
+	var param model.SnapVirtualAccount
+	if err := readSnapRequest(request, &param); err != nil {
+		writeSnapError(serviceCode, err, response)
+		return
+	}
+	totalAmount, err := parseSnapAmount("totalAmount", param.TotalAmount)
+	if err != nil {
+		writeSnapError(serviceCode, err, response)
+		return
+	}
+	expiredDate, err := parseSnapTime("expiredDate", param.ExpiredDate)
+	if err != nil {
+		writeSnapError(serviceCode, err, response)
+		return
+	}
+	virtualAccount, err := save(request.Request.Context(), &model.VirtualAccountRegister{
+		PartnerServiceID: param.PartnerServiceID,
+		CustomerNo:       param.CustomerNo,
+		VirtualAccountNo: param.VirtualAccountNo,
+		Name:             param.VirtualAccountName,
+		Email:            param.VirtualAccountEmail,
+		Phone:            param.VirtualAccountPhone,
+		TrxID:            param.TrxID,
+		TrxType:          param.VirtualAccountTrxType,
+		TotalAmount:      totalAmount,
+		Currency:         param.TotalAmount.Currency,
+		ExpiredDate:      expiredDate,
+	})
+	if err != nil {
+		logging.FromContext(request.Request.Context()).Error(err)
+		writeSnapError(serviceCode, err, response)
+		return
+	}
+	totalAmountData := toSnapAmount(virtualAccount.TotalAmount, virtualAccount.Currency)
+	writeSnapOK(serviceCode, model.SnapVirtualAccount{
+		PartnerServiceID:      param.PartnerServiceID,
+		CustomerNo:            param.CustomerNo,
+		VirtualAccountNo:      param.VirtualAccountNo,
+		VirtualAccountName:    virtualAccount.Name,
+		VirtualAccountEmail:   virtualAccount.Email,
+		VirtualAccountPhone:   virtualAccount.Phone,
+		TrxID:                 virtualAccount.TrxID,
+		TotalAmount:           &totalAmountData,
+		VirtualAccountTrxType: string(virtualAccount.TrxType),
+		ExpiredDate:           formatSnapTime(virtualAccount.ExpiredDate),
+		AdditionalInfo:        param.AdditionalInfo,
+	}, response)
+}
+
+// DeleteVA deactivate a virtual account
+func (snapController *SnapVirtualAccountController) DeleteVA(request *restful.Request, response *restful.Response) {
+	var param model.SnapDeleteVirtualAccount
+	if err := readSnapRequest(request, &param); err != nil {
+		writeSnapError(snapServiceDeleteVA, err, response)
+		return
+	}
+	_, err := snapController.VirtualAccountService.Delete(request.Request.Context(), param.PartnerServiceID, param.CustomerNo)
+	if err != nil {
+		logging.FromContext(request.Request.Context()).Error(err)
+		writeSnapError(snapServiceDeleteVA, err, response)
+		return
+	}
+	writeSnapOK(snapServiceDeleteVA, param, response)
+}
+
+// Inquiry get the bill of a virtual account before it is paid
+func (snapController *SnapVirtualAccountController) Inquiry(request *restful.Request, response *restful.Response) {
+	var param model.SnapInquiryRequest
+	if err := readSnapRequest(request, &param); err != nil {
+		writeSnapError(snapServiceInquiry, err, response)
+		return
+	}
+	virtualAccount, err := snapController.VirtualAccountService.Inquiry(request.Request.Context(),
+		param.PartnerServiceID, param.CustomerNo, param.InquiryRequestID)
+	if err != nil {
+		logging.FromContext(request.Request.Context()).Error(err)
+		writeSnapError(snapServiceInquiry, err, response)
+		return
+	}
+	writeSnapOK(snapServiceInquiry, model.SnapInquiryData{
+		InquiryStatus:         snapInquiryStatusSuccess,
+		InquiryReason:         snapSuccessReason,
+		PartnerServiceID:      param.PartnerServiceID,
+		CustomerNo:            param.CustomerNo,
+		VirtualAccountNo:      param.VirtualAccountNo,
+		VirtualAccountName:    virtualAccount.Name,
+		VirtualAccountEmail:   virtualAccount.Email,
+		VirtualAccountPhone:   virtualAccount.Phone,
+		InquiryRequestID:      param.InquiryRequestID,
+		TotalAmount:           toSnapAmount(virtualAccount.TotalAmount, virtualAccount.Currency),
+		VirtualAccountTrxType: string(virtualAccount.TrxType),
+		AdditionalInfo:        param.AdditionalInfo,
+	}, response)
+}
+
+// Payment pay a virtual account bill, crediting the account of the virtual account
+func (snapController *SnapVirtualAccountController) Payment(request *restful.Request, response *restful.Response) {
+	var param model.SnapPaymentRequest
+	if err := readSnapRequest(request, &param); err != nil {
+		writeSnapError(snapServicePayment, err, response)
+		return
+	}
+	paidAmount, err := parseSnapAmount("paidAmount", param.PaidAmount)
+	if err != nil {
+		writeSnapError(snapServicePayment, err, response)
+		return
+	}
+	virtualAccount, err := snapController.VirtualAccountService.Pay(request.Request.Context(), &model.VirtualAccountPayment{
+		PartnerServiceID: param.PartnerServiceID,
+		CustomerNo:       param.CustomerNo,
+		VirtualAccountNo: param.VirtualAccountNo,
+		PaymentRequestID: param.PaymentRequestID,
+		PaidAmount:       paidAmount,
+		Currency:         param.PaidAmount.Currency,
+	})
+	if err != nil {
+		logging.FromContext(request.Request.Context()).Error(err)
+		writeSnapError(snapServicePayment, err, response)
+		return
+	}
+	data := toSnapPaymentData(param.PartnerServiceID, param.CustomerNo, param.VirtualAccountNo, virtualAccount)
+	data.AdditionalInfo = param.AdditionalInfo
+	writeSnapOK(snapServicePayment, data, response)
+}
+
+// InquiryStatus get the payment status of a virtual account
+func (snapController *SnapVirtualAccountController) InquiryStatus(request *restful.Request, response *restful.Response) {
+	var param model.SnapInquiryStatusRequest
+	if err := readSnapRequest(request, &param); err != nil {
+		writeSnapError(snapServiceInquiryStatus, err, response)
+		return
+	}
+	virtualAccount, err := snapController.VirtualAccountService.FindPayment(request.Request.Context(),
+		param.PartnerServiceID, param.CustomerNo, param.PaymentRequestID)
+	if err != nil {
+		logging.FromContext(request.Request.Context()).Error(err)
+		writeSnapError(snapServiceInquiryStatus, err, response)
+		return
+	}
+	data := toSnapPaymentData(param.PartnerServiceID, param.CustomerNo, param.VirtualAccountNo, virtualAccount)
+	data.AdditionalInfo = param.AdditionalInfo
+	writeSnapOK(snapServiceInquiryStatus, data, response)
+}
+
+// readSnapRequest checks the SNAP headers, then reads and validates the request body into param.
+func readSnapRequest(request *restful.Request, param any) error {
+	if err := checkSnapHeaders(request); err != nil {
+		return err
+	}
+	if err := request.ReadEntity(param); err != nil {
+		logging.FromContext(request.Request.Context()).Error(err)
+		return &snapError{httpStatus: http.StatusBadRequest, caseCode: "00", message: "Bad Request. " + err.Error()}
+	}
+	return validation.Struct(param)
+}
+
+func toSnapPaymentData(partnerServiceID, customerNo, virtualAccountNo string, virtualAccount *domain.VirtualAccount) model.SnapPaymentData {
+	return model.SnapPaymentData{
+		PaymentFlagReason:  snapSuccessReason,
+		PartnerServiceID:   partnerServiceID,
+		CustomerNo:         customerNo,
+		VirtualAccountNo:   virtualAccountNo,
+		VirtualAccountName: virtualAccount.Name,
+		InquiryRequestID:   virtualAccount.InquiryRequestID,
+		PaymentRequestID:   virtualAccount.PaymentRequestID,
+		PaidAmount:         toSnapAmount(virtualAccount.PaidAmount, virtualAccount.Currency),
+		TotalAmount:        toSnapAmount(virtualAccount.TotalAmount, virtualAccount.Currency),
+		TrxDateTime:        formatSnapTime(virtualAccount.PaidAt),
+		ReferenceNo:        virtualAccount.PaymentTransactionID,
+		PaymentFlagStatus:  snapPaymentFlagSuccess,
+	}
+}
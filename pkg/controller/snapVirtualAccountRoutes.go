@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"net/http"
+
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	"github.com/mrth1995/go-mockva/pkg/model"
+)
+
+func (snapController *SnapVirtualAccountController) RegisterEndpoint(ws *restful.WebService) {
+	tags := []string{"SNAP Virtual Account"}
+	routes := []struct {
+		path        string
+		serviceCode string
+		handler     restful.RouteFunction
+		doc         string
+		reads       any
+		response    any
+	}{
+		{"/v1.0/transfer-va/create-va", snapServiceCreateVA, snapController.CreateVA, "Create virtual account", model.SnapVirtualAccount{}, model.SnapVirtualAccount{}},
+		{"/v1.0/transfer-va/update-va", snapServiceUpdateVA, snapController.UpdateVA, "Update virtual account", model.SnapVirtualAccount{}, model.SnapVirtualAccount{}},
+		{"/v1.0/transfer-va/delete-va", snapServiceDeleteVA, snapController.DeleteVA, "Delete virtual account", model.SnapDeleteVirtualAccount{}, model.SnapDeleteVirtualAccount{}},
+		{"/v1.0/transfer-va/inquiry", snapServiceInquiry, snapController.Inquiry, "Inquire virtual account bill", model.SnapInquiryRequest{}, model.SnapInquiryData{}},
+		{"/v1.0/transfer-va/payment", snapServicePayment, snapController.Payment, "Pay virtual account bill", model.SnapPaymentRequest{}, model.SnapPaymentData{}},
+		{"/v1.0/transfer-va/status", snapServiceInquiryStatus, snapController.InquiryStatus, "Inquire virtual account payment status", model.SnapInquiryStatusRequest{}, model.SnapPaymentData{}},
+	}
+	for _, route := range routes {
+		builder := ws.POST(route.path).
+			To(route.handler).
+			Doc(route.doc).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Reads(route.reads)
+		for _, header := range snapRequiredHeaders {
+			builder.Param(restful.HeaderParameter(header, header).Required(true))
+		}
+		ws.Route(builder.
+			Returns(http.StatusOK, "Successful", route.response).
+			Returns(http.StatusBadRequest, "Invalid field format or mandatory field", model.SnapResponse{}).
+			Returns(http.StatusUnauthorized, "Invalid signature", model.SnapResponse{}).
+			Returns(http.StatusNotFound, "Invalid bill, virtual account or amount", model.SnapResponse{}).
+			Returns(http.StatusConflict, "Conflict", model.SnapResponse{}).
+			Returns(http.StatusInternalServerError, "General error", model.SnapResponse{}).
+			Metadata(restfulspec.KeyOpenAPITags, tags).
+			Metadata(SnapServiceCodeKey, route.serviceCode).
+			Metadata(auth.RouteScopesKey, []string{auth.ScopeVirtualAccounts}))
+	}
+}
@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/logging"
+	"github.com/mrth1995/go-mockva/pkg/model"
+	"github.com/mrth1995/go-mockva/pkg/server/responseWriter"
+	"github.com/mrth1995/go-mockva/pkg/service"
+	"github.com/mrth1995/go-mockva/pkg/validation"
+)
+
+type TenantController struct {
+	TenantService *service.TenantService
+}
+
+func NewTenantController(tenantService *service.TenantService) *TenantController {
+	return &TenantController{TenantService: tenantService}
+}
+
+// Create provision a tenant with its settlement accounts
+func (tenantController *TenantController) Create(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+
+	var param model.TenantRegister
+	err := request.ReadEntity(&param)
+	if err != nil {
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteBadRequest(err, request, response)
+		return
+	}
+	if err = validation.Struct(&param); err != nil {
+		responseWriter.WriteError(err, request, response)
+		return
+	}
+	newTenant, err := tenantController.TenantService.Create(ctx, &param)
+	if err != nil {
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteError(err, request, response)
+		return
+	}
+	responseWriter.WriteOK(tenantController.TenantService.ToInfo(newTenant), response)
+}
+
+// FindAll get every tenant
+func (tenantController *TenantController) FindAll(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+
+	tenants, err := tenantController.TenantService.FindAll(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteError(err, request, response)
+		return
+	}
+	infos := make([]*model.TenantInfo, 0, len(tenants))
+	for i := range tenants {
+		infos = append(infos, tenantController.TenantService.ToInfo(&tenants[i]))
+	}
+	responseWriter.WriteOK(infos, response)
+}
+
+// FindByID get a tenant
+func (tenantController *TenantController) FindByID(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+
+	tenantID := request.PathParameter("tenantId")
+	existingTenant, err := tenantController.TenantService.FindByID(ctx, tenantID)
+	if err != nil {
+		logging.FromContext(ctx).Infof("Tenant %v not found", tenantID)
+		responseWriter.WriteError(err, request, response)
+		return
+	}
+	responseWriter.WriteOK(tenantController.TenantService.ToInfo(existingTenant), response)
+}
+
+// Wipe delete every account, transaction and virtual account of a tenant
+func (tenantController *TenantController) Wipe(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+
+	tenantID := request.PathParameter("tenantId")
+	if err := tenantController.TenantService.Wipe(ctx, tenantID); err != nil {
+		logging.FromContext(ctx).Error(err)
+		responseWriter.WriteError(err, request, response)
+		return
+	}
+	logging.FromContext(ctx).Infof("Tenant %v wiped", tenantID)
+	response.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"net/http"
+
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	endpointError "github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/model"
+)
+
+func (tenantController *TenantController) RegisterEndpoint(ws *restful.WebService) {
+	tags := []string{"Tenants"}
+	ws.Route(
+		ws.POST("/tenants").
+			To(tenantController.Create).
+			Doc("Provision a tenant with its own settlement accounts").
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON, endpointError.ProblemMediaType).
+			Reads(model.TenantRegister{}).
+			Returns(http.StatusOK, "Tenant provisioned", model.TenantInfo{}).
+			Returns(http.StatusBadRequest, "Validation error", endpointError.EndpointError{}).
+			Returns(http.StatusConflict, "Tenant already exist", endpointError.EndpointError{}).
+			Returns(http.StatusInternalServerError, "Internal server error", endpointError.EndpointError{}).
+			Metadata(restfulspec.KeyOpenAPITags, tags).
+			Metadata(auth.RouteAdminKey, true))
+	ws.Route(
+		ws.GET("/tenants").
+			To(tenantController.FindAll).
+			Produces(restful.MIME_JSON, endpointError.ProblemMediaType).
+			Returns(http.StatusOK, "Tenants", []model.TenantInfo{}).
+			Returns(http.StatusInternalServerError, "Internal server error", endpointError.EndpointError{}).
+			Metadata(restfulspec.KeyOpenAPITags, tags).
+			Metadata(auth.RouteAdminKey, true))
+	ws.Route(
+		ws.GET("/tenants/{tenantId}").
+			To(tenantController.FindByID).
+			Produces(restful.MIME_JSON, endpointError.ProblemMediaType).
+			Param(restful.PathParameter("tenantId", "Tenant ID")).
+			Returns(http.StatusOK, "Tenant exist", model.TenantInfo{}).
+			Returns(http.StatusNotFound, "Tenant not found", endpointError.EndpointError{}).
+			Returns(http.StatusInternalServerError, "Internal server error", endpointError.EndpointError{}).
+			Metadata(restfulspec.KeyOpenAPITags, tags).
+			Metadata(auth.RouteAdminKey, true))
+	ws.Route(
+		ws.POST("/tenants/{tenantId}/wipe").
+			To(tenantController.Wipe).
+			Doc("Delete every account, transaction and virtual account of a tenant, its settlement accounts are reset").
+			Produces(restful.MIME_JSON, endpointError.ProblemMediaType).
+			Param(restful.PathParameter("tenantId", "Tenant ID")).
+			Returns(http.StatusNoContent, "Tenant wiped", nil).
+			Returns(http.StatusNotFound, "Tenant not found", endpointError.EndpointError{}).
+			Returns(http.StatusInternalServerError, "Internal server error", endpointError.EndpointError{}).
+			Metadata(restfulspec.KeyOpenAPITags, tags).
+			Metadata(auth.RouteAdminKey, true))
+}
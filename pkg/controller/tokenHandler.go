@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	"github.com/mrth1995/go-mockva/pkg/logging"
+	"github.com/mrth1995/go-mockva/pkg/model"
+	"github.com/mrth1995/go-mockva/pkg/server/responseWriter"
+	"github.com/mrth1995/go-mockva/pkg/service"
+)
+
+const (
+	grantTypeClientCredentials = "client_credentials"
+	grantTypeRefreshToken      = "refresh_token"
+)
+
+type TokenController struct {
+	TokenService *service.TokenService
+}
+
+func NewTokenController(tokenService *service.TokenService) *TokenController {
+	return &TokenController{TokenService: tokenService}
+}
+
+// Token issue tokens with the client_credentials or refresh_token grant of RFC 6749
+func (tokenController *TokenController) Token(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+
+	if err := request.Request.ParseForm(); err != nil {
+		writeOAuthError(auth.NewOAuthError(auth.OAuthInvalidRequest, err.Error()), request, response)
+		return
+	}
+	form := request.Request.PostForm
+	scopes := auth.ParseScope(form.Get("scope"))
+
+	var token *auth.Token
+	var err error
+	switch form.Get("grant_type") {
+	case grantTypeClientCredentials:
+		clientID, clientSecret, ok := request.Request.BasicAuth()
+		if !ok {
+			clientID, clientSecret = form.Get("client_id"), form.Get("client_secret")
+		}
+		token, err = tokenController.TokenService.ClientCredentials(ctx, clientID, clientSecret, scopes)
+	case grantTypeRefreshToken:
+		token, err = tokenController.TokenService.Refresh(ctx, form.Get("refresh_token"), scopes)
+	case "":
+		err = auth.NewOAuthError(auth.OAuthInvalidRequest, "grant_type is required")
+	default:
+		err = auth.NewOAuthError(auth.OAuthUnsupportedGrantType, "grant_type must be client_credentials or refresh_token")
+	}
+	if err != nil {
+		logging.FromContext(ctx).Infof("Token request rejected: %v", err)
+		writeOAuthError(err, request, response)
+		return
+	}
+	response.AddHeader("Cache-Control", "no-store")
+	responseWriter.WriteOK(model.TokenResponse{
+		AccessToken:  token.AccessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(token.ExpiresIn / time.Second),
+		RefreshToken: token.RefreshToken,
+		Scope:        auth.FormatScope(token.Scopes),
+	}, response)
+}
+
+// SnapAccessToken issue a SNAP B2B access token to the client whose X-SIGNATURE was verified
+func (tokenController *TokenController) SnapAccessToken(request *restful.Request, response *restful.Response) {
+	for _, header := range []string{SnapHeaderTimestamp, SnapHeaderClientKey, SnapHeaderSignature} {
+		if strings.TrimSpace(request.HeaderParameter(header)) == "" {
+			writeSnapAccessTokenError(invalidMandatoryField(header), response)
+			return
+		}
+	}
+	var param model.SnapAccessTokenRequest
+	if err := request.ReadEntity(&param); err != nil {
+		logging.FromContext(request.Request.Context()).Error(err)
+		writeSnapAccessTokenError(&snapError{httpStatus: http.StatusBadRequest, caseCode: "00", message: "Bad Request. " + err.Error()}, response)
+		return
+	}
+	if param.GrantType != grantTypeClientCredentials {
+		writeSnapAccessTokenError(invalidFieldFormat("grantType"), response)
+		return
+	}
+	token, err := tokenController.TokenService.IssueForClient(request.Request.Context(), request.HeaderParameter(SnapHeaderClientKey))
+	if err != nil {
+		logging.FromContext(request.Request.Context()).Infof("SNAP access token request rejected: %v", err)
+		writeSnapAccessTokenError(err, response)
+		return
+	}
+	response.AddHeader("Cache-Control", "no-store")
+	responseWriter.WriteOK(model.SnapAccessTokenResponse{
+		ResponseCode:    snapResponseCode(http.StatusOK, snapServiceAccessToken, "00"),
+		ResponseMessage: "Successful",
+		AccessToken:     token.AccessToken,
+		TokenType:       "Bearer",
+		ExpiresIn:       strconv.Itoa(int(token.ExpiresIn / time.Second)),
+	}, response)
+}
+
+func writeOAuthError(e error, request *restful.Request, response *restful.Response) {
+	oauthErr, ok := e.(*auth.OAuthError)
+	if !ok {
+		logging.FromContext(request.Request.Context()).Error(e)
+		oauthErr = &auth.OAuthError{Code: "server_error", HTTPStatus: http.StatusInternalServerError}
+	}
+	if oauthErr.HTTPStatus == http.StatusUnauthorized {
+		response.AddHeader("WWW-Authenticate", `Basic realm="mockva"`)
+	}
+	response.AddHeader("Cache-Control", "no-store")
+	responseWriter.WriteStatus(oauthErr.HTTPStatus, oauthErr, response)
+}
+
+func writeSnapAccessTokenError(e error, response *restful.Response) {
+	snapErr, ok := e.(*snapError)
+	if !ok {
+		snapErr = toSnapError(e)
+	}
+	responseWriter.WriteStatus(snapErr.httpStatus, model.SnapAccessTokenResponse{
+		ResponseCode:    snapResponseCode(snapErr.httpStatus, snapServiceAccessToken, snapErr.caseCode),
+		ResponseMessage: snapErr.message,
+	}, response)
+}
@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"net/http"
+
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	"github.com/mrth1995/go-mockva/pkg/model"
+	"github.com/mrth1995/go-mockva/pkg/signature"
+)
+
+const formMediaType = "application/x-www-form-urlencoded"
+
+func (tokenController *TokenController) RegisterEndpoint(ws *restful.WebService) {
+	ws.Route(
+		ws.POST("/oauth2/token").
+			To(tokenController.Token).
+			Doc("Issue bearer tokens with the client_credentials or refresh_token grant").
+			Consumes(formMediaType).
+			Produces(restful.MIME_JSON).
+			Param(ws.FormParameter("grant_type", "client_credentials or refresh_token").Required(true)).
+			Param(ws.FormParameter("client_id", "Client ID, when not sent with HTTP basic authentication")).
+			Param(ws.FormParameter("client_secret", "Client secret, when not sent with HTTP basic authentication")).
+			Param(ws.FormParameter("refresh_token", "Refresh token of the refresh_token grant")).
+			Param(ws.FormParameter("scope", "Space separated scopes, all scopes of the client when omitted")).
+			Returns(http.StatusOK, "Tokens issued", model.TokenResponse{}).
+			Returns(http.StatusBadRequest, "Invalid request, grant or scope", auth.OAuthError{}).
+			Returns(http.StatusUnauthorized, "Invalid client credentials", auth.OAuthError{}).
+			Metadata(restfulspec.KeyOpenAPITags, []string{"OAuth2"}))
+}
+
+// RegisterSnapEndpoint registers the SNAP B2B access token route, authenticated by the asymmetric
+// signature of the request instead of a client secret.
+func (tokenController *TokenController) RegisterSnapEndpoint(ws *restful.WebService) {
+	ws.Route(
+		ws.POST("/v1.0/access-token/b2b").
+			To(tokenController.SnapAccessToken).
+			Doc("Issue a SNAP B2B access token").
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Reads(model.SnapAccessTokenRequest{}).
+			Param(restful.HeaderParameter(SnapHeaderTimestamp, SnapHeaderTimestamp).Required(true)).
+			Param(restful.HeaderParameter(SnapHeaderClientKey, SnapHeaderClientKey).Required(true)).
+			Param(restful.HeaderParameter(SnapHeaderSignature, SnapHeaderSignature).Required(true)).
+			Returns(http.StatusOK, "Successful", model.SnapAccessTokenResponse{}).
+			Returns(http.StatusBadRequest, "Invalid field format or mandatory field", model.SnapAccessTokenResponse{}).
+			Returns(http.StatusUnauthorized, "Invalid signature or client", model.SnapAccessTokenResponse{}).
+			Metadata(restfulspec.KeyOpenAPITags, []string{"SNAP Access Token"}).
+			Metadata(SnapServiceCodeKey, snapServiceAccessToken).
+			Metadata(signature.RouteKindKey, signature.KindAsymmetric))
+}
@@ -2,12 +2,49 @@ package domain
 
 import "time"
 
+// TransactionType describes how funds were moved by an AccountTransaction.
+type TransactionType string
+
+const (
+	TransactionTypeTransfer   TransactionType = "TRANSFER"
+	TransactionTypeDeposit    TransactionType = "DEPOSIT"
+	TransactionTypeWithdrawal TransactionType = "WITHDRAWAL"
+)
+
+// TransactionStatus describes where an AccountTransaction is in its lifecycle.
+// A transaction starts as PENDING when it is settled asynchronously, then becomes
+// SUCCESS or FAILED. A SUCCESS transaction can later be REVERSED.
+type TransactionStatus string
+
+const (
+	TransactionStatusPending  TransactionStatus = "PENDING"
+	TransactionStatusSuccess  TransactionStatus = "SUCCESS"
+	TransactionStatusFailed   TransactionStatus = "FAILED"
+	TransactionStatusReversed TransactionStatus = "REVERSED"
+)
+
 type AccountTransaction struct {
-	ID                   string          `json:"id" gorm:"varchar(32);primaryKey"`
-	TransactionTimestamp time.Time       `json:"transactionTimestamp" gorm:"not null"`
-	Amount               float64         `json:"amount" gorm:"not null"`
-	AccountSrcId         string          `json:"accountSrcId" gorm:"<-:false;varchar(32);column:accountSrcId"`
-	AccountDstId         string          `json:"accountDstId" gorm:"<-:false;varchar(32);column:accountDstId"`
-	AccountSrc           *AccountBalance `json:"-" gorm:"<-;->:false"`
-	AccountDst           *AccountBalance `json:"-" gorm:"<-;->:false"`
+	ID                   string            `json:"id" gorm:"varchar(40);primaryKey"`
+	TenantID             string            `json:"-" gorm:"varchar(64);not null"`
+	TransactionTimestamp time.Time         `json:"transactionTimestamp" gorm:"not null"`
+	Amount               float64           `json:"amount" gorm:"not null"`
+	Type                 TransactionType   `json:"type" gorm:"varchar(16);not null;column:transaction_type"`
+	Status               TransactionStatus `json:"status" gorm:"varchar(16);not null"`
+	StatusReason         string            `json:"statusReason,omitempty" gorm:"varchar(255)"`
+	SettledAt            *time.Time        `json:"settledAt,omitempty"`
+	Channel              string            `json:"channel,omitempty" gorm:"varchar(32)"`
+	Reference            string            `json:"reference,omitempty" gorm:"varchar(64)"`
+	Remark               string            `json:"remark,omitempty" gorm:"varchar(255)"`
+	ExternalReference    string            `json:"externalReference,omitempty" gorm:"varchar(64)"`
+	PurposeCode          string            `json:"purposeCode,omitempty" gorm:"varchar(8)"`
+	Metadata             map[string]string `json:"metadata,omitempty" gorm:"serializer:json;type:jsonb"`
+	ClientID             string            `json:"clientId,omitempty" gorm:"varchar(64)"`
+	AccountSrcId         string            `json:"accountSrcId" gorm:"varchar(32);not null;column:account_src_id"`
+	AccountDstId         string            `json:"accountDstId" gorm:"varchar(32);not null;column:account_dst_id"`
+	CreatedAt            time.Time         `json:"-"`
+	UpdatedAt            time.Time         `json:"-"`
+	// AccountSrc and AccountDst are the balances locked while posting the transaction. They are
+	// not persisted, the transaction only references the accounts by ID.
+	AccountSrc *AccountBalance `json:"-" gorm:"-"`
+	AccountDst *AccountBalance `json:"-" gorm:"-"`
 }
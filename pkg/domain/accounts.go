@@ -5,18 +5,31 @@ import (
 )
 
 type Account struct {
+	TenantID  string    `json:"-" gorm:"varchar(64);primaryKey"`
 	ID        string    `json:"-" gorm:"varchar(32);primaryKey"`
 	AccountID string    `json:"accountId" gorm:"varchar(32);not null;unique"`
 	Name      string    `json:"name" gorm:"varchar(50);not null"`
 	Address   string    `json:"address" gorm:"text"`
 	BirthDate time.Time `json:"birthDate" gorm:"not null"`
 	Gender    bool      `json:"gender" gorm:"not null"`
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"-"`
 }
 
 type AccountBalance struct {
-	ID                   string   `json:"-" gorm:"varchar(32);primaryKey"`
-	AccountID            string   `json:"accountId" gorm:"varchar(32);not null;unique"`
-	Account              *Account `json:"-" gorm:"<-;->:false"`
-	Balance              float64  `json:"balance" gorm:"decimal(10,2);not null"`
-	AllowNegativeBalance bool     `json:"allowNegativeBalance" gorm:"not null"`
+	TenantID             string    `json:"-" gorm:"varchar(64);primaryKey"`
+	ID                   string    `json:"-" gorm:"varchar(32);primaryKey"`
+	AccountID            string    `json:"accountId" gorm:"varchar(32);not null;unique"`
+	Account              *Account  `json:"-" gorm:"<-;->:false"`
+	Balance              float64   `json:"balance" gorm:"decimal(10,2);not null;column:amount"`
+	AllowNegativeBalance bool      `json:"allowNegativeBalance" gorm:"not null"`
+	CreatedAt            time.Time `json:"-"`
+	UpdatedAt            time.Time `json:"-"`
 }
+
+// System settlement accounts used as the counterpart of cash-in and cash-out
+// transactions. Every tenant has its own, seeded when the tenant is provisioned.
+const (
+	CashInSettlementAccountID  = "SETTLEMENT-CASHIN"
+	CashOutSettlementAccountID = "SETTLEMENT-CASHOUT"
+)
@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+// APIClient is a partner allowed to call mockva. Clients are managed by the mockva admin.
+type APIClient struct {
+	ID   string `gorm:"varchar(64);primaryKey"`
+	Name string `gorm:"varchar(255);not null"`
+	// TenantID is the only tenant the client may operate on. Clients without one may choose the
+	// tenant of each request with X-TENANT-ID.
+	TenantID string `gorm:"varchar(64)"`
+	// SecretHash is the SHA-256 hex digest of the API secret the client authenticates with.
+	SecretHash string `gorm:"varchar(64)"`
+	// PublicKey is the PEM encoded RSA or ECDSA public key verifying token request signatures.
+	PublicKey string `gorm:"text"`
+	// ClientSecret is the key of the HMAC-SHA512 signatures of service requests. Unlike the API
+	// secret it cannot be hashed, since mockva has to compute the signatures.
+	ClientSecret string `gorm:"varchar(255)"`
+	// Scopes are the scopes the client's bearer tokens may be granted.
+	Scopes []string `gorm:"serializer:json;type:jsonb"`
+	// AllowedIPs are the IP addresses and CIDR ranges the client may call from, any when empty.
+	AllowedIPs []string `gorm:"serializer:json;type:jsonb"`
+	Enabled    bool     `gorm:"not null"`
+	// RateLimit is the number of requests per minute the client may make, unlimited when 0.
+	RateLimit int `gorm:"not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "time"
+
+// Tenant isolates the accounts, balances, transactions and virtual accounts of a team sharing
+// mockva with others. Account IDs only need to be unique within a tenant.
+type Tenant struct {
+	ID        string `gorm:"varchar(64);primaryKey"`
+	Name      string `gorm:"varchar(255);not null"`
+	CreatedAt time.Time
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+type VirtualAccountStatus string
+
+const (
+	VirtualAccountStatusActive  VirtualAccountStatus = "ACTIVE"
+	VirtualAccountStatusPaid    VirtualAccountStatus = "PAID"
+	VirtualAccountStatusDeleted VirtualAccountStatus = "DELETED"
+)
+
+// VirtualAccountTrxType tells how much a virtual account bill may be paid with.
+type VirtualAccountTrxType string
+
+const (
+	// VirtualAccountTrxTypeClosed bills must be paid with exactly their total amount.
+	VirtualAccountTrxTypeClosed VirtualAccountTrxType = "C"
+	// VirtualAccountTrxTypeOpen bills accept any positive amount.
+	VirtualAccountTrxTypeOpen VirtualAccountTrxType = "O"
+)
+
+// VirtualAccount is a bill that credits the account whose ID equals the customer number
+// once it is paid.
+type VirtualAccount struct {
+	TenantID             string                `gorm:"varchar(64);primaryKey"`
+	VirtualAccountNo     string                `gorm:"varchar(40);primaryKey"`
+	PartnerServiceID     string                `gorm:"varchar(8);not null"`
+	CustomerNo           string                `gorm:"varchar(32);not null"`
+	AccountID            string                `gorm:"varchar(32);not null"`
+	Name                 string                `gorm:"varchar(255);not null"`
+	Email                string                `gorm:"varchar(255)"`
+	Phone                string                `gorm:"varchar(30)"`
+	TrxID                string                `gorm:"varchar(64)"`
+	TrxType              VirtualAccountTrxType `gorm:"varchar(1);not null"`
+	TotalAmount          float64               `gorm:"decimal(10,2);not null"`
+	Currency             string                `gorm:"varchar(3);not null"`
+	ExpiredDate          *time.Time
+	Status               VirtualAccountStatus `gorm:"varchar(16);not null"`
+	InquiryRequestID     string               `gorm:"varchar(128)"`
+	PaymentRequestID     string               `gorm:"varchar(128)"`
+	PaymentTransactionID string               `gorm:"varchar(40)"`
+	PaidAmount           float64              `gorm:"decimal(10,2)"`
+	PaidAt               *time.Time
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+// VirtualAccountNo builds the virtual account number out of the partner service ID and the
+// customer number. The partner service ID is sent left-padded with spaces, so both parts
+// are trimmed.
+func VirtualAccountNo(partnerServiceID, customerNo string) string {
+	return strings.TrimSpace(partnerServiceID) + strings.TrimSpace(customerNo)
+}
+
+// IsExpired tells whether the bill can no longer be inquired or paid at the given time.
+func (va *VirtualAccount) IsExpired(now time.Time) bool {
+	return va.ExpiredDate != nil && now.After(*va.ExpiredDate)
+}
@@ -1,24 +1,216 @@
 package errors
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Bank-style response codes returned in EndpointError.ErrorCode.
+const (
+	CodeValidation             = "30"
+	CodeInvalidAmount          = "13"
+	CodeVirtualAccountNotFound = "14"
+	CodeTransactionNotFound    = "25"
+	CodeInsufficientFunds      = "51"
+	CodeVirtualAccountExpired  = "54"
+	CodeAPIClientNotFound      = "56"
+	CodeForbidden              = "57"
+	CodeLimitExceeded          = "61"
+	CodeAccountLocked          = "62"
+	CodeUnauthorized           = "63"
+	CodeTooManyRequests        = "65"
+	CodeAccountAlreadyExist    = "68"
+	CodeAccountNotFound        = "76"
+	CodeTenantNotFound         = "78"
+	CodeBillAlreadyPaid        = "88"
+	CodeTimeout                = "91"
+	CodeConflict               = "94"
+	CodeInternal               = "96"
+)
+
+// EndpointError is an error that carries the HTTP status and the bank-style response code
+// written to the client. Two EndpointErrors match with errors.Is when their codes are equal,
+// so the catalog variables below can be used as sentinels.
 type EndpointError struct {
-	ErrorMessage string `json:"errorMessage"`
-	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string       `json:"errorMessage"`
+	ErrorCode    string       `json:"errorCode"`
+	Errors       []FieldError `json:"errors,omitempty"`
+	HTTPStatus   int          `json:"-"`
+}
+
+// FieldError describes why a single request field is invalid.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
 }
 
 func (e *EndpointError) Error() string {
 	return e.ErrorMessage
 }
 
-func NewAccountAlreadyExist(accountID string) error {
+func (e *EndpointError) Is(target error) bool {
+	t, ok := target.(*EndpointError)
+	return ok && t.ErrorCode == e.ErrorCode
+}
+
+// Status returns the HTTP status of the error, defaulting to 500 when none is set.
+func (e *EndpointError) Status() int {
+	if e.HTTPStatus == 0 {
+		return http.StatusInternalServerError
+	}
+	return e.HTTPStatus
+}
+
+// Error catalog. Use the constructors below to create errors with a specific message,
+// and these variables to match them with errors.Is.
+var (
+	ErrValidation             = &EndpointError{ErrorMessage: "Validation error", ErrorCode: CodeValidation, HTTPStatus: http.StatusBadRequest}
+	ErrAccountNotFound        = &EndpointError{ErrorMessage: "Account not found", ErrorCode: CodeAccountNotFound, HTTPStatus: http.StatusNotFound}
+	ErrTransactionNotFound    = &EndpointError{ErrorMessage: "Transaction not found", ErrorCode: CodeTransactionNotFound, HTTPStatus: http.StatusNotFound}
+	ErrAccountAlreadyExist    = &EndpointError{ErrorMessage: "Account already exist", ErrorCode: CodeAccountAlreadyExist, HTTPStatus: http.StatusConflict}
+	ErrConflict               = &EndpointError{ErrorMessage: "Conflict", ErrorCode: CodeConflict, HTTPStatus: http.StatusConflict}
+	ErrInsufficientFunds      = &EndpointError{ErrorMessage: "Insufficient funds", ErrorCode: CodeInsufficientFunds, HTTPStatus: http.StatusUnprocessableEntity}
+	ErrLimitExceeded          = &EndpointError{ErrorMessage: "Limit exceeded", ErrorCode: CodeLimitExceeded, HTTPStatus: http.StatusUnprocessableEntity}
+	ErrAccountLocked          = &EndpointError{ErrorMessage: "Account locked", ErrorCode: CodeAccountLocked, HTTPStatus: http.StatusLocked}
+	ErrVirtualAccountNotFound = &EndpointError{ErrorMessage: "Virtual account not found", ErrorCode: CodeVirtualAccountNotFound, HTTPStatus: http.StatusNotFound}
+	ErrVirtualAccountExpired  = &EndpointError{ErrorMessage: "Virtual account expired", ErrorCode: CodeVirtualAccountExpired, HTTPStatus: http.StatusUnprocessableEntity}
+	ErrInvalidAmount          = &EndpointError{ErrorMessage: "Invalid amount", ErrorCode: CodeInvalidAmount, HTTPStatus: http.StatusUnprocessableEntity}
+	ErrBillAlreadyPaid        = &EndpointError{ErrorMessage: "Bill already paid", ErrorCode: CodeBillAlreadyPaid, HTTPStatus: http.StatusConflict}
+	ErrAPIClientNotFound      = &EndpointError{ErrorMessage: "API client not found", ErrorCode: CodeAPIClientNotFound, HTTPStatus: http.StatusNotFound}
+	ErrUnauthorized           = &EndpointError{ErrorMessage: "Unauthorized", ErrorCode: CodeUnauthorized, HTTPStatus: http.StatusUnauthorized}
+	ErrForbidden              = &EndpointError{ErrorMessage: "Forbidden", ErrorCode: CodeForbidden, HTTPStatus: http.StatusForbidden}
+	ErrTenantNotFound         = &EndpointError{ErrorMessage: "Tenant not found", ErrorCode: CodeTenantNotFound, HTTPStatus: http.StatusNotFound}
+	ErrTooManyRequests        = &EndpointError{ErrorMessage: "Too many requests", ErrorCode: CodeTooManyRequests, HTTPStatus: http.StatusTooManyRequests}
+	ErrTimeout                = &EndpointError{ErrorMessage: "Request timed out", ErrorCode: CodeTimeout, HTTPStatus: http.StatusServiceUnavailable}
+	ErrInternal               = &EndpointError{ErrorMessage: "Internal server error", ErrorCode: CodeInternal, HTTPStatus: http.StatusInternalServerError}
+)
+
+// From converts any error into an EndpointError. Requests running out of time are reported as
+// timeouts, the other errors that are not EndpointErrors as internal errors with a generic message,
+// since theirs may hold driver or other internal details. Callers log the original error.
+func From(e error) *EndpointError {
+	var endpointErr *EndpointError
+	if errors.As(e, &endpointErr) {
+		return endpointErr
+	}
+	if errors.Is(e, context.DeadlineExceeded) {
+		return &EndpointError{ErrorMessage: "request timed out", ErrorCode: CodeTimeout, HTTPStatus: ErrTimeout.HTTPStatus}
+	}
 	return &EndpointError{
-		ErrorMessage: "Account with ID" + accountID + " already exist",
-		ErrorCode:    "68",
+		ErrorMessage: ErrInternal.ErrorMessage,
+		ErrorCode:    CodeInternal,
+		HTTPStatus:   http.StatusInternalServerError,
 	}
 }
 
+// Is reports whether any error in err's chain matches target. It lets packages importing
+// this package as errors match catalog errors without importing the standard library one.
+func Is(err, target error) bool {
+	return errors.Is(err, target)
+}
+
+// As finds the first error in err's chain that matches target, like Is for error types.
+func As(err error, target any) bool {
+	return errors.As(err, target)
+}
+
+func NewValidationError(message string) error {
+	return newError(ErrValidation, message)
+}
+
+func NewValidationErrorf(format string, args ...any) error {
+	return newError(ErrValidation, fmt.Sprintf(format, args...))
+}
+
+// NewFieldValidationError creates a validation error listing every invalid field.
+func NewFieldValidationError(fieldErrors []FieldError) error {
+	messages := make([]string, 0, len(fieldErrors))
+	for _, fieldErr := range fieldErrors {
+		messages = append(messages, fieldErr.Field+" "+fieldErr.Message)
+	}
+	return &EndpointError{
+		ErrorMessage: strings.Join(messages, "; "),
+		ErrorCode:    CodeValidation,
+		Errors:       fieldErrors,
+		HTTPStatus:   ErrValidation.HTTPStatus,
+	}
+}
+
+func NewAccountAlreadyExist(accountID string) error {
+	return newError(ErrAccountAlreadyExist, "Account with ID "+accountID+" already exist")
+}
+
 func NewAccountNotFound(accountID string) error {
+	return newError(ErrAccountNotFound, "Account with ID "+accountID+" not found")
+}
+
+func NewTransactionNotFound(transactionID string) error {
+	return newError(ErrTransactionNotFound, "Transaction with ID "+transactionID+" not found")
+}
+
+func NewConflict(message string) error {
+	return newError(ErrConflict, message)
+}
+
+func NewInsufficientFunds(message string) error {
+	return newError(ErrInsufficientFunds, message)
+}
+
+func NewLimitExceeded(message string) error {
+	return newError(ErrLimitExceeded, message)
+}
+
+func NewAccountLocked(accountID string) error {
+	return newError(ErrAccountLocked, "Account with ID "+accountID+" is locked")
+}
+
+func NewVirtualAccountNotFound(virtualAccountNo string) error {
+	return newError(ErrVirtualAccountNotFound, "Virtual account "+virtualAccountNo+" not found")
+}
+
+func NewVirtualAccountExpired(virtualAccountNo string) error {
+	return newError(ErrVirtualAccountExpired, "Virtual account "+virtualAccountNo+" is expired")
+}
+
+func NewInvalidAmount(message string) error {
+	return newError(ErrInvalidAmount, message)
+}
+
+func NewBillAlreadyPaid(virtualAccountNo string) error {
+	return newError(ErrBillAlreadyPaid, "Virtual account "+virtualAccountNo+" is already paid")
+}
+
+func NewAPIClientNotFound(clientID string) error {
+	return newError(ErrAPIClientNotFound, "API client "+clientID+" not found")
+}
+
+func NewTenantNotFound(tenantID string) error {
+	return newError(ErrTenantNotFound, "Tenant "+tenantID+" not found")
+}
+
+func NewUnauthorized(message string) error {
+	return newError(ErrUnauthorized, message)
+}
+
+func NewForbidden(message string) error {
+	return newError(ErrForbidden, message)
+}
+
+func NewTooManyRequests(message string) error {
+	return newError(ErrTooManyRequests, message)
+}
+
+func NewTimeout(message string) error {
+	return newError(ErrTimeout, message)
+}
+
+func newError(kind *EndpointError, message string) error {
 	return &EndpointError{
-		ErrorMessage: "Account with ID" + accountID + " not found",
-		ErrorCode:    "76",
+		ErrorMessage: message,
+		ErrorCode:    kind.ErrorCode,
+		HTTPStatus:   kind.HTTPStatus,
 	}
 }
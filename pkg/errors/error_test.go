@@ -0,0 +1,54 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointError_Is(t *testing.T) {
+	err := fmt.Errorf("transfer failed: %w", NewInsufficientFunds("insufficient amount"))
+
+	assertions := require.New(t)
+	assertions.True(errors.Is(err, ErrInsufficientFunds))
+	assertions.False(errors.Is(err, ErrValidation))
+}
+
+func TestFrom(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedCode   string
+	}{
+		{name: "Validation", err: NewValidationError("invalid amount"), expectedStatus: http.StatusBadRequest, expectedCode: CodeValidation},
+		{name: "Account not found", err: NewAccountNotFound("001"), expectedStatus: http.StatusNotFound, expectedCode: CodeAccountNotFound},
+		{name: "Transaction not found", err: NewTransactionNotFound("TRX"), expectedStatus: http.StatusNotFound, expectedCode: CodeTransactionNotFound},
+		{name: "Already exist", err: NewAccountAlreadyExist("001"), expectedStatus: http.StatusConflict, expectedCode: CodeAccountAlreadyExist},
+		{name: "Insufficient funds", err: NewInsufficientFunds("insufficient amount"), expectedStatus: http.StatusUnprocessableEntity, expectedCode: CodeInsufficientFunds},
+		{name: "Limit exceeded", err: NewLimitExceeded("daily limit"), expectedStatus: http.StatusUnprocessableEntity, expectedCode: CodeLimitExceeded},
+		{name: "Locked", err: NewAccountLocked("001"), expectedStatus: http.StatusLocked, expectedCode: CodeAccountLocked},
+		{name: "Wrapped", err: fmt.Errorf("wrapped: %w", NewConflict("conflict")), expectedStatus: http.StatusConflict, expectedCode: CodeConflict},
+		{name: "Timeout", err: NewTimeout("statement timeout"), expectedStatus: http.StatusServiceUnavailable, expectedCode: CodeTimeout},
+		{name: "Deadline exceeded", err: fmt.Errorf("query: %w", context.DeadlineExceeded), expectedStatus: http.StatusServiceUnavailable, expectedCode: CodeTimeout},
+		{name: "Unknown", err: errors.New("connection refused"), expectedStatus: http.StatusInternalServerError, expectedCode: CodeInternal},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			endpointErr := From(tc.err)
+			assertions := require.New(t)
+			assertions.Equal(tc.expectedStatus, endpointErr.Status())
+			assertions.Equal(tc.expectedCode, endpointErr.ErrorCode)
+		})
+	}
+}
+
+func TestFrom_HidesInternalErrorMessage(t *testing.T) {
+	endpointErr := From(errors.New(`pq: relation "account_balances" does not exist`))
+	require.Equal(t, "Internal server error", endpointErr.ErrorMessage)
+}
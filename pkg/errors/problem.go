@@ -0,0 +1,73 @@
+package errors
+
+import (
+	"slices"
+	"strings"
+)
+
+// ProblemMediaType is the media type of RFC 7807 problem details responses.
+const ProblemMediaType = "application/problem+json"
+
+// problemTypeBase prefixes the type URI of every problem written by mockva.
+const problemTypeBase = "/mockva/problems/"
+
+// Problem is an RFC 7807 problem details response. It carries the bank-style response
+// code and the per-field validation failures as extension members.
+type Problem struct {
+	Type     string       `json:"type"`
+	Title    string       `json:"title"`
+	Status   int          `json:"status"`
+	Detail   string       `json:"detail,omitempty"`
+	Instance string       `json:"instance,omitempty"`
+	Code     string       `json:"code"`
+	TraceID  string       `json:"traceId,omitempty"`
+	Errors   []FieldError `json:"errors,omitempty"`
+}
+
+var catalog = []*EndpointError{
+	ErrValidation,
+	ErrAccountNotFound,
+	ErrTransactionNotFound,
+	ErrAccountAlreadyExist,
+	ErrConflict,
+	ErrInsufficientFunds,
+	ErrLimitExceeded,
+	ErrAccountLocked,
+	ErrVirtualAccountNotFound,
+	ErrVirtualAccountExpired,
+	ErrInvalidAmount,
+	ErrBillAlreadyPaid,
+	ErrAPIClientNotFound,
+	ErrTenantNotFound,
+	ErrUnauthorized,
+	ErrForbidden,
+	ErrTooManyRequests,
+	ErrTimeout,
+	ErrInternal,
+}
+
+// Catalog returns the errors of the catalog, e.g. to document the responses of the routes.
+func Catalog() []*EndpointError {
+	return slices.Clone(catalog)
+}
+
+// NewProblem converts e into problem details for the given status, request path and trace ID.
+func NewProblem(e *EndpointError, status int, instance, traceID string) *Problem {
+	kind := ErrInternal
+	for _, entry := range catalog {
+		if entry.ErrorCode == e.ErrorCode {
+			kind = entry
+			break
+		}
+	}
+	return &Problem{
+		Type:     problemTypeBase + strings.ReplaceAll(strings.ToLower(kind.ErrorMessage), " ", "-"),
+		Title:    kind.ErrorMessage,
+		Status:   status,
+		Detail:   e.ErrorMessage,
+		Instance: instance,
+		Code:     e.ErrorCode,
+		TraceID:  traceID,
+		Errors:   e.Errors,
+	}
+}
@@ -0,0 +1,114 @@
+// Package health reports whether mockva is alive and ready to serve traffic, from the checks of
+// the components it depends on.
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Statuses of mockva and of its components.
+const (
+	StatusUp       = "UP"
+	StatusDown     = "DOWN"
+	StatusDraining = "DRAINING"
+)
+
+// Check verifies that a component works, returning why it does not.
+type Check func(ctx context.Context) error
+
+// ComponentStatus is the result of the check of a component.
+type ComponentStatus struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report is the readiness of mockva along with the status of each component.
+type Report struct {
+	Status     string                     `json:"status"`
+	Components map[string]ComponentStatus `json:"components,omitempty"`
+}
+
+// Ready reports whether mockva may receive traffic.
+func (r *Report) Ready() bool {
+	return r.Status == StatusUp
+}
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker runs the checks of the registered components. It is safe for concurrent use.
+type Checker struct {
+	timeout  time.Duration
+	draining atomic.Bool
+
+	mu     sync.RWMutex
+	checks []namedCheck
+}
+
+// NewChecker creates a Checker with no component.
+// Parameters:
+//   - timeout: How long each check may take before its component is reported down
+//
+// Returns:
+//   - *Checker: A checker reporting mockva ready until components are registered
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{timeout: timeout}
+}
+
+// Register adds a component checked on every readiness report.
+func (c *Checker) Register(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+// StartDraining makes mockva report that it is not ready while it shuts down, so that traffic is
+// routed elsewhere before the server stops accepting connections.
+func (c *Checker) StartDraining() {
+	c.draining.Store(true)
+}
+
+// Readiness runs every check concurrently and reports mockva ready when all of them pass and it
+// is not draining.
+func (c *Checker) Readiness(ctx context.Context) *Report {
+	c.mu.RLock()
+	checks := append([]namedCheck(nil), c.checks...)
+	c.mu.RUnlock()
+
+	statuses := make([]ComponentStatus, len(checks))
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			statuses[i] = c.run(ctx, check.check)
+		}()
+	}
+	wg.Wait()
+
+	report := &Report{Status: StatusUp, Components: make(map[string]ComponentStatus, len(checks))}
+	for i, check := range checks {
+		report.Components[check.name] = statuses[i]
+		if statuses[i].Status != StatusUp {
+			report.Status = StatusDown
+		}
+	}
+	if c.draining.Load() {
+		report.Status = StatusDraining
+	}
+	return report
+}
+
+func (c *Checker) run(ctx context.Context, check Check) ComponentStatus {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	if err := check(ctx); err != nil {
+		return ComponentStatus{Status: StatusDown, Error: err.Error()}
+	}
+	return ComponentStatus{Status: StatusUp}
+}
@@ -0,0 +1,54 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecker_Readiness(t *testing.T) {
+	up := func(ctx context.Context) error { return nil }
+	down := func(ctx context.Context) error { return errors.New("connection refused") }
+	slow := func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	testCases := []struct {
+		name               string
+		checks             map[string]Check
+		draining           bool
+		expectedStatus     string
+		expectedComponents map[string]ComponentStatus
+	}{
+		{name: "No component", expectedStatus: StatusUp, expectedComponents: map[string]ComponentStatus{}},
+		{name: "All up", checks: map[string]Check{"database": up, "migrations": up}, expectedStatus: StatusUp,
+			expectedComponents: map[string]ComponentStatus{"database": {Status: StatusUp}, "migrations": {Status: StatusUp}}},
+		{name: "One down", checks: map[string]Check{"database": down, "migrations": up}, expectedStatus: StatusDown,
+			expectedComponents: map[string]ComponentStatus{"database": {Status: StatusDown, Error: "connection refused"}, "migrations": {Status: StatusUp}}},
+		{name: "Timed out", checks: map[string]Check{"database": slow}, expectedStatus: StatusDown,
+			expectedComponents: map[string]ComponentStatus{"database": {Status: StatusDown, Error: context.DeadlineExceeded.Error()}}},
+		{name: "Draining", checks: map[string]Check{"database": up}, draining: true, expectedStatus: StatusDraining,
+			expectedComponents: map[string]ComponentStatus{"database": {Status: StatusUp}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			checker := NewChecker(10 * time.Millisecond)
+			for name, check := range tc.checks {
+				checker.Register(name, check)
+			}
+			if tc.draining {
+				checker.StartDraining()
+			}
+
+			report := checker.Readiness(context.Background())
+			assertions := require.New(t)
+			assertions.Equal(tc.expectedStatus, report.Status)
+			assertions.Equal(tc.expectedStatus == StatusUp, report.Ready())
+			assertions.Equal(tc.expectedComponents, report.Components)
+		})
+	}
+}
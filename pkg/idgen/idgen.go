@@ -0,0 +1,76 @@
+// Package idgen generates unique, time-sortable identifiers for transactions and other records.
+package idgen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+const (
+	KindULID      = "ulid"
+	KindUUIDv7    = "uuidv7"
+	KindReference = "reference"
+)
+
+// seededEpoch is the first timestamp produced by a seeded generator.
+var seededEpoch = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Generator produces unique identifiers. Implementations are safe for concurrent use and
+// never return the same identifier twice, even when called within the same clock tick.
+type Generator interface {
+	NewID() string
+}
+
+// New creates a Generator of the given kind.
+// When seed is not zero the generator is deterministic: its clock starts at a fixed epoch and
+// advances by one millisecond per identifier, and its randomness is derived from the seed, so
+// the same seed always yields the same sequence of identifiers. Every start of a seeded
+// generator repeats that sequence, so seeded generators are meant for tests against a fresh
+// database and must not be used with a database that keeps the identifiers of an earlier run.
+// Parameters:
+//   - kind: One of KindULID, KindUUIDv7 or KindReference
+//   - seed: Zero for production use, any other value for a reproducible sequence
+//
+// Returns:
+//   - Generator: The identifier generator
+//   - error: If kind is unknown
+func New(kind string, seed int64) (Generator, error) {
+	src := newSource(seed)
+	switch kind {
+	case KindULID, "":
+		return &ulidGenerator{src: src}, nil
+	case KindUUIDv7:
+		return &uuidV7Generator{src: src}, nil
+	case KindReference:
+		return &referenceGenerator{src: src}, nil
+	default:
+		return nil, fmt.Errorf("unknown id generator %q", kind)
+	}
+}
+
+// source provides the clock and the randomness shared by every generator kind.
+type source struct {
+	mu   sync.Mutex
+	rand *rand.Rand
+	now  func() time.Time
+}
+
+func newSource(seed int64) *source {
+	if seed == 0 {
+		return &source{
+			rand: rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())),
+			now:  time.Now,
+		}
+	}
+	next := seededEpoch
+	return &source{
+		rand: rand.New(rand.NewPCG(uint64(seed), uint64(seed))),
+		now: func() time.Time {
+			current := next
+			next = next.Add(time.Millisecond)
+			return current
+		},
+	}
+}
@@ -0,0 +1,113 @@
+package idgen
+
+import (
+	"regexp"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_UnknownKind(t *testing.T) {
+	generator, err := New("snowflake", 0)
+
+	assertions := require.New(t)
+	assertions.Nil(generator)
+	assertions.NotNil(err)
+}
+
+func TestGenerator_Format(t *testing.T) {
+	testCases := []struct {
+		kind    string
+		pattern string
+	}{
+		{kind: KindULID, pattern: `^[0-9A-HJKMNP-TV-Z]{26}$`},
+		{kind: KindUUIDv7, pattern: `^[0-9a-f]{8}-[0-9a-f]{4}-7[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`},
+		{kind: KindReference, pattern: `^[0-9]{20}$`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.kind, func(t *testing.T) {
+			generator, err := New(tc.kind, 0)
+			require.Nil(t, err)
+			require.Regexp(t, regexp.MustCompile(tc.pattern), generator.NewID())
+		})
+	}
+}
+
+func TestGenerator_UniqueAndSorted(t *testing.T) {
+	for _, kind := range []string{KindULID, KindUUIDv7, KindReference} {
+		t.Run(kind, func(t *testing.T) {
+			generator, err := New(kind, 0)
+			require.Nil(t, err)
+
+			ids := make([]string, 10_000)
+			for i := range ids {
+				ids[i] = generator.NewID()
+			}
+
+			assertions := require.New(t)
+			assertions.True(sort.StringsAreSorted(ids), "IDs should be sortable by creation order")
+			seen := make(map[string]struct{}, len(ids))
+			for _, id := range ids {
+				_, duplicate := seen[id]
+				assertions.Falsef(duplicate, "Duplicate ID %v", id)
+				seen[id] = struct{}{}
+			}
+		})
+	}
+}
+
+func TestGenerator_Concurrent(t *testing.T) {
+	generator, err := New(KindULID, 0)
+	require.Nil(t, err)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	seen := map[string]struct{}{}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1_000; j++ {
+				id := generator.NewID()
+				mu.Lock()
+				seen[id] = struct{}{}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, seen, 8_000, "Every ID should be unique")
+}
+
+func TestGenerator_Seeded(t *testing.T) {
+	for _, kind := range []string{KindULID, KindUUIDv7, KindReference} {
+		t.Run(kind, func(t *testing.T) {
+			first, err := New(kind, 42)
+			require.Nil(t, err)
+			second, err := New(kind, 42)
+			require.Nil(t, err)
+			other, err := New(kind, 7)
+			require.Nil(t, err)
+
+			assertions := require.New(t)
+			for i := 0; i < 100; i++ {
+				id := first.NewID()
+				assertions.Equal(id, second.NewID(), "Same seed should produce the same sequence")
+				if kind != KindReference {
+					assertions.NotEqual(id, other.NewID(), "Different seed should produce a different sequence")
+				}
+			}
+		})
+	}
+}
+
+func TestULID_Encoding(t *testing.T) {
+	assertions := require.New(t)
+	assertions.Equal("00000000000000000000000000", encodeULID(0, 0, 0))
+	assertions.Equal("7ZZZZZZZZZZZZZZZZZZZZZZZZZ", encodeULID(1<<48-1, 0xffff, 1<<64-1))
+	assertions.Equal("01ARZ3NDEK", encodeULID(1469922850259, 0, 0)[:10])
+}
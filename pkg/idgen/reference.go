@@ -0,0 +1,39 @@
+package idgen
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	referenceTimeFormat  = "20060102150405"
+	maxReferenceSequence = 999_999
+)
+
+// referenceGenerator produces 20 digit bank-style reference numbers: the UTC timestamp
+// down to the second followed by a 6 digit sequence. The sequence restarts every second
+// from a random offset; when it is exhausted the generator borrows the next second.
+type referenceGenerator struct {
+	src      *source
+	lastSec  int64
+	sequence int
+}
+
+func (g *referenceGenerator) NewID() string {
+	g.src.mu.Lock()
+	defer g.src.mu.Unlock()
+
+	sec := g.src.now().Unix()
+	if sec <= g.lastSec {
+		sec = g.lastSec
+		g.sequence++
+		if g.sequence > maxReferenceSequence {
+			sec++
+			g.sequence = 0
+		}
+	} else {
+		g.sequence = g.src.rand.IntN(1000)
+	}
+	g.lastSec = sec
+	return fmt.Sprintf("%s%06d", time.Unix(sec, 0).UTC().Format(referenceTimeFormat), g.sequence)
+}
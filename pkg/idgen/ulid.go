@@ -0,0 +1,67 @@
+package idgen
+
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// ulidGenerator produces 26 character ULIDs: a 48-bit millisecond timestamp followed by
+// 80 bits of randomness, encoded in Crockford base32. IDs generated within the same
+// millisecond increment the random part so they stay unique and sorted.
+type ulidGenerator struct {
+	src    *source
+	lastMs uint64
+	// entropy holds the 80 random bits as a 16-bit high part and a 64-bit low part.
+	entropyHi uint16
+	entropyLo uint64
+}
+
+func (g *ulidGenerator) NewID() string {
+	g.src.mu.Lock()
+	defer g.src.mu.Unlock()
+
+	ms := uint64(g.src.now().UnixMilli())
+	if ms <= g.lastMs {
+		ms = g.lastMs
+		g.entropyLo++
+		if g.entropyLo == 0 {
+			g.entropyHi++
+			if g.entropyHi == 0 {
+				ms++
+			}
+		}
+	} else {
+		g.entropyHi = uint16(g.src.rand.Uint32())
+		g.entropyLo = g.src.rand.Uint64()
+	}
+	g.lastMs = ms
+	return encodeULID(ms, g.entropyHi, g.entropyLo)
+}
+
+func encodeULID(ms uint64, entropyHi uint16, entropyLo uint64) string {
+	var id [16]byte
+	id[0] = byte(ms >> 40)
+	id[1] = byte(ms >> 32)
+	id[2] = byte(ms >> 24)
+	id[3] = byte(ms >> 16)
+	id[4] = byte(ms >> 8)
+	id[5] = byte(ms)
+	id[6] = byte(entropyHi >> 8)
+	id[7] = byte(entropyHi)
+	for i := 0; i < 8; i++ {
+		id[8+i] = byte(entropyLo >> (56 - 8*i))
+	}
+
+	// 128 bits are encoded as 26 characters of 5 bits, the first character carrying only 3 bits.
+	var out [26]byte
+	var acc uint32
+	bits := 2
+	pos := 0
+	for _, b := range id {
+		acc = acc<<8 | uint32(b)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			out[pos] = crockfordAlphabet[(acc>>bits)&0x1f]
+			pos++
+		}
+	}
+	return string(out[:])
+}
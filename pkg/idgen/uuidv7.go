@@ -0,0 +1,56 @@
+package idgen
+
+import "encoding/hex"
+
+// uuidV7Generator produces RFC 9562 version 7 UUIDs. The 12-bit rand_a field is used as a
+// counter for IDs generated within the same millisecond, so IDs stay unique and sorted.
+type uuidV7Generator struct {
+	src     *source
+	lastMs  uint64
+	counter uint16
+}
+
+func (g *uuidV7Generator) NewID() string {
+	g.src.mu.Lock()
+	defer g.src.mu.Unlock()
+
+	ms := uint64(g.src.now().UnixMilli())
+	if ms <= g.lastMs {
+		ms = g.lastMs
+		g.counter++
+		if g.counter > 0x0fff {
+			ms++
+			g.counter = 0
+		}
+	} else {
+		g.counter = uint16(g.src.rand.Uint32()) & 0x07ff
+	}
+	g.lastMs = ms
+
+	var id [16]byte
+	id[0] = byte(ms >> 40)
+	id[1] = byte(ms >> 32)
+	id[2] = byte(ms >> 24)
+	id[3] = byte(ms >> 16)
+	id[4] = byte(ms >> 8)
+	id[5] = byte(ms)
+	id[6] = 0x70 | byte(g.counter>>8)
+	id[7] = byte(g.counter)
+	randB := g.src.rand.Uint64()
+	for i := 0; i < 8; i++ {
+		id[8+i] = byte(randB >> (56 - 8*i))
+	}
+	id[8] = 0x80 | id[8]&0x3f
+
+	var out [36]byte
+	hex.Encode(out[0:8], id[0:4])
+	out[8] = '-'
+	hex.Encode(out[9:13], id[4:6])
+	out[13] = '-'
+	hex.Encode(out[14:18], id[6:8])
+	out[18] = '-'
+	hex.Encode(out[19:23], id[8:10])
+	out[23] = '-'
+	hex.Encode(out[24:], id[10:])
+	return string(out[:])
+}
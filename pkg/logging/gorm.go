@@ -0,0 +1,70 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// GormLogger writes the failed and slow queries of GORM to the logger of the request that ran
+// them, and every query at debug level.
+type GormLogger struct {
+	slowThreshold time.Duration
+}
+
+// NewGormLogger creates a new GormLogger.
+// Parameters:
+//   - slowThreshold: Queries taking longer are logged as warnings
+//
+// Returns:
+//   - *GormLogger: A logger to set in gorm.Config
+func NewGormLogger(slowThreshold time.Duration) *GormLogger {
+	return &GormLogger{slowThreshold: slowThreshold}
+}
+
+// LogMode is a no-op, the level is the one of logrus.
+func (l *GormLogger) LogMode(logger.LogLevel) logger.Interface {
+	return l
+}
+
+func (l *GormLogger) Info(ctx context.Context, msg string, args ...any) {
+	FromContext(ctx).Infof(msg, args...)
+}
+
+func (l *GormLogger) Warn(ctx context.Context, msg string, args ...any) {
+	FromContext(ctx).Warnf(msg, args...)
+}
+
+func (l *GormLogger) Error(ctx context.Context, msg string, args ...any) {
+	FromContext(ctx).Errorf(msg, args...)
+}
+
+// Trace logs a query once it finished. Records not found are not failures, the repositories
+// report them.
+func (l *GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	elapsed := time.Since(begin)
+	log := FromContext(ctx)
+	switch {
+	case err != nil && !errors.Is(err, gorm.ErrRecordNotFound):
+		sql, rows := fc()
+		log.WithError(err).WithFields(queryFields(sql, rows, elapsed)).Error("query failed")
+	case l.slowThreshold > 0 && elapsed > l.slowThreshold:
+		sql, rows := fc()
+		log.WithFields(queryFields(sql, rows, elapsed)).Warn("slow query")
+	case log.Logger.IsLevelEnabled(logrus.DebugLevel):
+		sql, rows := fc()
+		log.WithFields(queryFields(sql, rows, elapsed)).Debug("query")
+	}
+}
+
+func queryFields(sql string, rows int64, elapsed time.Duration) logrus.Fields {
+	return logrus.Fields{
+		"sql":       sql,
+		"rows":      rows,
+		"latencyMs": elapsed.Milliseconds(),
+	}
+}
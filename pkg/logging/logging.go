@@ -0,0 +1,108 @@
+// Package logging configures the logrus output of mockva and carries a request-scoped logger
+// through the request context, so that every log line of a request can be correlated with its
+// X-Request-ID.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Formats of the log lines.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+const millisecondTimeFormat = "2006-01-02T15:04:05.999Z07:00"
+
+// Setup configures the format and level of the standard logrus logger, writing to stdout.
+// Parameters:
+//   - format: text or json
+//   - level: A logrus level, e.g. debug, info or warn
+//
+// Returns:
+//   - error: When the format or the level is unknown
+func Setup(format, level string) error {
+	logLevel, err := logrus.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	var formatter logrus.Formatter
+	switch format {
+	case FormatText:
+		formatter = &logrus.TextFormatter{FullTimestamp: true, TimestampFormat: millisecondTimeFormat}
+	case FormatJSON:
+		formatter = &logrus.JSONFormatter{TimestampFormat: millisecondTimeFormat}
+	default:
+		return fmt.Errorf("unknown log format %q, expected %s or %s", format, FormatText, FormatJSON)
+	}
+	logrus.SetOutput(os.Stdout)
+	logrus.SetLevel(logLevel)
+	logrus.SetFormatter(formatter)
+	return nil
+}
+
+type scopeKey struct{}
+
+// scope is what a request accumulates for its access log while it is processed.
+type scope struct {
+	logger    *logrus.Entry
+	requestID string
+
+	mu         sync.Mutex
+	accountIDs []string
+}
+
+// NewContext returns a copy of ctx carrying the logger of the request identified by requestID.
+func NewContext(ctx context.Context, logger *logrus.Entry, requestID string) context.Context {
+	return context.WithValue(ctx, scopeKey{}, &scope{logger: logger, requestID: requestID})
+}
+
+// FromContext returns the logger of the request ctx belongs to, the standard logger outside of
+// requests.
+func FromContext(ctx context.Context) *logrus.Entry {
+	if s, ok := ctx.Value(scopeKey{}).(*scope); ok {
+		return s.logger
+	}
+	return logrus.NewEntry(logrus.StandardLogger())
+}
+
+// RequestID returns the X-Request-ID of the request ctx belongs to, empty outside of requests.
+func RequestID(ctx context.Context) string {
+	if s, ok := ctx.Value(scopeKey{}).(*scope); ok {
+		return s.requestID
+	}
+	return ""
+}
+
+// AddAccountIDs records the accounts the request ctx belongs to operates on, for its access log.
+func AddAccountIDs(ctx context.Context, accountIDs ...string) {
+	s, ok := ctx.Value(scopeKey{}).(*scope)
+	if !ok {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, accountID := range accountIDs {
+		if accountID != "" && !slices.Contains(s.accountIDs, accountID) {
+			s.accountIDs = append(s.accountIDs, accountID)
+		}
+	}
+}
+
+// AccountIDs returns the accounts the request ctx belongs to operated on.
+func AccountIDs(ctx context.Context) []string {
+	s, ok := ctx.Value(scopeKey{}).(*scope)
+	if !ok {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.accountIDs)
+}
@@ -0,0 +1,44 @@
+package logging
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetup(t *testing.T) {
+	defer logrus.SetLevel(logrus.GetLevel())
+	defer logrus.SetFormatter(logrus.StandardLogger().Formatter)
+
+	assertions := require.New(t)
+	assertions.Nil(Setup(FormatJSON, "debug"))
+	assertions.IsType(&logrus.JSONFormatter{}, logrus.StandardLogger().Formatter)
+	assertions.Equal(logrus.DebugLevel, logrus.GetLevel())
+
+	assertions.Nil(Setup(FormatText, "warn"))
+	assertions.IsType(&logrus.TextFormatter{}, logrus.StandardLogger().Formatter)
+	assertions.Equal(logrus.WarnLevel, logrus.GetLevel())
+
+	assertions.ErrorContains(Setup("xml", "info"), "unknown log format")
+	assertions.NotNil(Setup(FormatJSON, "loud"))
+}
+
+func TestContext(t *testing.T) {
+	assertions := require.New(t)
+
+	ctx := context.Background()
+	assertions.Equal("", RequestID(ctx))
+	assertions.NotNil(FromContext(ctx))
+	AddAccountIDs(ctx, "1234")
+	assertions.Nil(AccountIDs(ctx))
+
+	logger := logrus.WithField("requestId", "req-1")
+	ctx = NewContext(ctx, logger, "req-1")
+	assertions.Equal("req-1", RequestID(ctx))
+	assertions.Same(logger, FromContext(ctx))
+	AddAccountIDs(ctx, "1234", "5678")
+	AddAccountIDs(ctx, "5678", "", "9012")
+	assertions.Equal([]string{"1234", "5678", "9012"}, AccountIDs(ctx))
+}
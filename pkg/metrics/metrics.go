@@ -0,0 +1,99 @@
+// Package metrics holds the Prometheus collectors of mockva, exposed on /metrics: HTTP requests,
+// transfers, balance lock waits and the database connection pool.
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "mockva"
+
+// Outcomes of a transfer that did not reach a status.
+const (
+	OutcomeRejected = "rejected"
+	OutcomeError    = "error"
+)
+
+var (
+	// Registry holds every mockva collector, along with the Go runtime and process ones.
+	Registry = prometheus.NewRegistry()
+
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by route and response status.",
+	}, []string{"method", "route", "status"})
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by route and response status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+	transfers = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "transfers_total",
+		Help:      "Transfers, deposits and withdrawals by type and outcome.",
+	}, []string{"type", "outcome"})
+	transferAmount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "transfer_amount_total",
+		Help:      "Amount of the transfers, deposits and withdrawals by type and outcome.",
+	}, []string{"type", "outcome"})
+	lockWait = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "balance_lock_wait_seconds",
+		Help:      "Time spent acquiring the row lock of an account balance.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpRequestDuration,
+		transfers,
+		transferAmount,
+		lockWait,
+	)
+}
+
+// Handler serves the metrics of Registry in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// RegisterDB exposes the connection pool statistics of db, as returned by db.Stats().
+func RegisterDB(db *sql.DB, dbName string) error {
+	return Registry.Register(collectors.NewDBStatsCollector(db, dbName))
+}
+
+// ObserveRequest records an HTTP request served by route, the path it was registered with so that
+// path parameters do not multiply the series.
+func ObserveRequest(method, route string, status int, duration time.Duration) {
+	statusLabel := strconv.Itoa(status)
+	httpRequests.WithLabelValues(method, route, statusLabel).Inc()
+	httpRequestDuration.WithLabelValues(method, route, statusLabel).Observe(duration.Seconds())
+}
+
+// ObserveTransfer records a transfer of the given type and amount, its outcome being the status it
+// reached or OutcomeRejected or OutcomeError when it was not recorded.
+func ObserveTransfer(transactionType, outcome string, amount float64) {
+	labels := []string{strings.ToLower(transactionType), strings.ToLower(outcome)}
+	transfers.WithLabelValues(labels...).Inc()
+	transferAmount.WithLabelValues(labels...).Add(amount)
+}
+
+// ObserveLockWait records how long acquiring the row lock of an account balance took.
+func ObserveLockWait(duration time.Duration) {
+	lockWait.Observe(duration.Seconds())
+}
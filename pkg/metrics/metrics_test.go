@@ -0,0 +1,28 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserveTransfer(t *testing.T) {
+	ObserveTransfer("TRANSFER", "SUCCESS", 100_000)
+	ObserveTransfer("TRANSFER", "SUCCESS", 50_000)
+	ObserveTransfer("TRANSFER", OutcomeRejected, 10_000)
+
+	assertions := require.New(t)
+	assertions.Equal(float64(2), testutil.ToFloat64(transfers.WithLabelValues("transfer", "success")))
+	assertions.Equal(float64(150_000), testutil.ToFloat64(transferAmount.WithLabelValues("transfer", "success")))
+	assertions.Equal(float64(1), testutil.ToFloat64(transfers.WithLabelValues("transfer", "rejected")))
+}
+
+func TestObserveRequest(t *testing.T) {
+	ObserveRequest("GET", "/mockva/accounts/{accountId}", 404, 20*time.Millisecond)
+
+	assertions := require.New(t)
+	assertions.Equal(float64(1), testutil.ToFloat64(httpRequests.WithLabelValues("GET", "/mockva/accounts/{accountId}", "404")))
+	assertions.Equal(1, testutil.CollectAndCount(httpRequestDuration, namespace+"_http_request_duration_seconds"))
+}
@@ -1,26 +1,44 @@
 package migration
 
 import (
+	"context"
 	"database/sql"
+	"embed"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
+	"strconv"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
 	"github.com/sirupsen/logrus"
 )
 
+// files are the migrations, built into the binary.
+//
+//go:embed postgresql/*.sql
+var files embed.FS
+
+const sourceDir = "postgresql"
+
 type Migration struct {
 	mgrt  *migrate.Migrate
 	sqlDB *sql.DB
 }
 
-func NewMigration(sqlDB *sql.DB, dbName, sourceFile string) (*Migration, error) {
+func NewMigration(sqlDB *sql.DB, dbName string) (*Migration, error) {
+	sourceDriver, err := iofs.New(files, sourceDir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read migrations: %v", err)
+	}
 	dbDriver, err := postgres.WithInstance(sqlDB, &postgres.Config{})
 	if err != nil {
 		return nil, fmt.Errorf("unable to connect to db: %v", err)
 	}
-	migrate, err := migrate.NewWithDatabaseInstance(fmt.Sprintf("file://%s", sourceFile), dbName, dbDriver)
+	migrate, err := migrate.NewWithInstance("iofs", sourceDriver, dbName, dbDriver)
 	if err != nil {
 		return nil, fmt.Errorf("migration failed: %v", err)
 	}
@@ -30,25 +48,221 @@ func NewMigration(sqlDB *sql.DB, dbName, sourceFile string) (*Migration, error)
 	}, nil
 }
 
+// Up applies every migration not applied yet.
 func (m *Migration) Up() error {
 	logrus.Println("Start schema migration . . .")
-	_, isDirty, err := m.mgrt.Version()
-	if err != nil && err != migrate.ErrNilVersion {
-		logrus.Println("Database schema migration failed")
+	if err := m.ensureClean(); err != nil {
 		return err
 	}
-	if isDirty {
-		logrus.Println("Database schema migration failed")
-		logrus.Println("In order to protect your data integrity please fix you database schema using this tools.")
-		logrus.Println("https://github.com/golang-migrate/migrate/releases")
-	}
-	if err = m.mgrt.Up(); err == migrate.ErrNoChange {
+	if err := m.mgrt.Up(); err == migrate.ErrNoChange {
 		logrus.Println("No changes, database schema is up to date")
 		return nil
+	} else if err != nil {
+		logrus.Println("Database schema migration failed")
+		return err
+	}
+	return nil
+}
+
+// Down reverts the last applied migration.
+func (m *Migration) Down() error {
+	if err := m.ensureClean(); err != nil {
+		return err
+	}
+	if _, _, err := m.mgrt.Version(); err == migrate.ErrNilVersion {
+		return errors.New("database schema is not migrated, there is no migration to revert")
+	}
+	return m.mgrt.Steps(-1)
+}
+
+// Goto applies or reverts migrations until the schema is at version.
+func (m *Migration) Goto(version uint) error {
+	if err := m.ensureClean(); err != nil {
+		return err
+	}
+	if err := m.mgrt.Migrate(version); err != nil && err != migrate.ErrNoChange {
+		return err
+	}
+	return nil
+}
+
+// Force records version as the version of the schema and clears its dirty flag without running
+// any migration, once a migration that failed halfway has been completed or undone by hand.
+// Version -1 records that no migration is applied.
+func (m *Migration) Force(version int) error {
+	return m.mgrt.Force(version)
+}
+
+// Status describes the version of the database schema.
+type Status struct {
+	// Version is the version of the last applied migration, 0 when none is.
+	Version uint
+	// Dirty tells that the migration of Version failed halfway.
+	Dirty bool
+	// Latest is the version of the last migration built into mockva.
+	Latest uint
+}
+
+func (s *Status) String() string {
+	version := "none"
+	if s.Version > 0 {
+		version = strconv.FormatUint(uint64(s.Version), 10)
 	}
+	return fmt.Sprintf("version: %s\ndirty: %t\nlatest: %d\n", version, s.Dirty, s.Latest)
+}
+
+// Status returns the version of the database schema and of the latest migration. The version is
+// read with ctx, so that a slow database cannot hold the caller past its deadline.
+func (m *Migration) Status(ctx context.Context) (*Status, error) {
+	latest, err := LatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	var version int64
+	var isDirty bool
+	row := m.sqlDB.QueryRowContext(ctx, "SELECT version, dirty FROM "+postgres.DefaultMigrationsTable+" LIMIT 1")
+	if err = row.Scan(&version, &isDirty); err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("unable to read the database schema version: %w", err)
+	}
+	return &Status{Version: uint(version), Dirty: isDirty, Latest: latest}, nil
+}
+
+// Check verifies that the database schema is at the latest version of the migration files and
+// that no migration failed halfway, leaving it dirty.
+func (m *Migration) Check(ctx context.Context) error {
+	status, err := m.Status(ctx)
 	if err != nil {
-		logrus.Println("Database schema migration failed")
 		return err
 	}
+	if status.Version == 0 {
+		return fmt.Errorf("database schema is not migrated, expected version %d", status.Latest)
+	}
+	if status.Dirty {
+		return fmt.Errorf("database schema version %d is dirty", status.Version)
+	}
+	if status.Version != status.Latest {
+		return fmt.Errorf("database schema is at version %d, expected version %d", status.Version, status.Latest)
+	}
+	return nil
+}
+
+// Close releases the connection of the migration and closes the database.
+func (m *Migration) Close() error {
+	sourceErr, dbErr := m.mgrt.Close()
+	return errors.Join(sourceErr, dbErr)
+}
+
+// ensureClean refuses to migrate a schema a migration left dirty, since it is at neither the
+// version before nor the one after it.
+func (m *Migration) ensureClean() error {
+	version, isDirty, err := m.mgrt.Version()
+	if err != nil && err != migrate.ErrNilVersion {
+		return err
+	}
+	if isDirty {
+		return dirtyError(version)
+	}
 	return nil
 }
+
+// dirtyError tells how to recover the schema the migration of version left dirty: force either that
+// version or the one before it, -1 when it is the first migration, since golang-migrate has no version 0.
+func dirtyError(version uint) error {
+	previous := int(version) - 1
+	if previous == 0 {
+		previous = -1
+	}
+	return fmt.Errorf("database schema version %d is dirty: the migration failed halfway. Complete or undo it by hand, "+
+		"then record the version the schema is at with `mockva migrate force %d` or `mockva migrate force %d`", version, version, previous)
+}
+
+// LatestVersion returns the version of the last migration built into mockva.
+func LatestVersion() (uint, error) {
+	driver, err := iofs.New(files, sourceDir)
+	if err != nil {
+		return 0, fmt.Errorf("unable to read migrations: %v", err)
+	}
+	defer driver.Close()
+	return latestVersion(driver)
+}
+
+func latestVersion(driver source.Driver) (uint, error) {
+	version, err := driver.First()
+	if err != nil {
+		return 0, fmt.Errorf("unable to read migrations: %v", err)
+	}
+	for {
+		next, err := driver.Next(version)
+		if errors.Is(err, fs.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("unable to read migrations: %v", err)
+		}
+		version = next
+	}
+}
+
+// Command is a `mockva migrate` subcommand.
+type Command struct {
+	// Name is up, down, goto, force or status.
+	Name string
+	// Version is the argument of goto and force.
+	Version int
+}
+
+// ParseCommand parses the arguments of `mockva migrate`: up, down, goto N, force N or status,
+// followed by the configuration flags, which are returned.
+func ParseCommand(args []string) (*Command, []string, error) {
+	const usage = "usage: mockva migrate up|down|goto N|force N|status [flags]"
+	if len(args) == 0 {
+		return nil, nil, errors.New(usage)
+	}
+	command := &Command{Name: args[0]}
+	switch command.Name {
+	case "up", "down", "status":
+		return command, args[1:], nil
+	case "goto", "force":
+		if len(args) < 2 {
+			return nil, nil, fmt.Errorf("%s needs a version, %s", command.Name, usage)
+		}
+		version, err := strconv.Atoi(args[1])
+		if err != nil || version < 0 && !(command.Name == "force" && version == -1) {
+			return nil, nil, fmt.Errorf("invalid version %q of %s", args[1], command.Name)
+		}
+		if command.Name == "goto" && version == 0 {
+			// There is no migration 0, so golang-migrate fails to go to it instead of reverting every migration.
+			return nil, nil, fmt.Errorf("goto needs a version of at least 1, %s", usage)
+		}
+		command.Version = version
+		return command, args[2:], nil
+	}
+	return nil, nil, fmt.Errorf("unknown migrate command %q, %s", command.Name, usage)
+}
+
+// Run executes command then writes the status of the schema to w.
+func (m *Migration) Run(command *Command, w io.Writer) error {
+	var err error
+	switch command.Name {
+	case "up":
+		err = m.Up()
+	case "down":
+		err = m.Down()
+	case "goto":
+		err = m.Goto(uint(command.Version))
+	case "force":
+		err = m.Force(command.Version)
+	case "status":
+	default:
+		err = fmt.Errorf("unknown migrate command %q", command.Name)
+	}
+	if err != nil {
+		return err
+	}
+	status, err := m.Status(context.Background())
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, status.String())
+	return err
+}
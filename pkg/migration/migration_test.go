@@ -0,0 +1,75 @@
+package migration
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatestVersion(t *testing.T) {
+	version, err := LatestVersion()
+
+	assertions := require.New(t)
+	assertions.Nil(err)
+	assertions.Equal(uint(11), version)
+
+	empty, err := iofs.New(fstest.MapFS{"migrations/README.md": {}}, "migrations")
+	assertions.Nil(err)
+	_, err = latestVersion(empty)
+	assertions.NotNil(err)
+}
+
+func TestParseCommand(t *testing.T) {
+	testCases := []struct {
+		name            string
+		args            []string
+		expectedCommand *Command
+		expectedArgs    []string
+		expectedErr     bool
+	}{
+		{name: "Up", args: []string{"up"}, expectedCommand: &Command{Name: "up"}, expectedArgs: []string{}},
+		{name: "Status with flags", args: []string{"status", "--postgres-host", "db"}, expectedCommand: &Command{Name: "status"}, expectedArgs: []string{"--postgres-host", "db"}},
+		{name: "Goto", args: []string{"goto", "9", "--config", "mockva.yaml"}, expectedCommand: &Command{Name: "goto", Version: 9}, expectedArgs: []string{"--config", "mockva.yaml"}},
+		{name: "Force no version", args: []string{"force", "-1"}, expectedCommand: &Command{Name: "force", Version: -1}, expectedArgs: []string{}},
+		{name: "Goto negative version", args: []string{"goto", "-1"}, expectedErr: true},
+		{name: "Goto version 0", args: []string{"goto", "0"}, expectedErr: true},
+		{name: "Force without version", args: []string{"force"}, expectedErr: true},
+		{name: "Invalid version", args: []string{"goto", "latest"}, expectedErr: true},
+		{name: "Unknown command", args: []string{"drop"}, expectedErr: true},
+		{name: "No command", args: []string{}, expectedErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			command, args, err := ParseCommand(tc.args)
+			assertions := require.New(t)
+			if tc.expectedErr {
+				assertions.NotNil(err)
+				return
+			}
+			assertions.Nil(err)
+			assertions.Equal(tc.expectedCommand, command)
+			assertions.Equal(tc.expectedArgs, args)
+		})
+	}
+}
+
+func TestStatus_String(t *testing.T) {
+	assertions := require.New(t)
+	assertions.Equal("version: 10\ndirty: true\nlatest: 11\n", (&Status{Version: 10, Dirty: true, Latest: 11}).String())
+	assertions.Equal("version: none\ndirty: false\nlatest: 11\n", (&Status{Latest: 11}).String())
+}
+
+func TestDirtyError(t *testing.T) {
+	testCases := []struct {
+		version      uint
+		expectedHint string
+	}{
+		{version: 1, expectedHint: "`mockva migrate force 1` or `mockva migrate force -1`"},
+		{version: 7, expectedHint: "`mockva migrate force 7` or `mockva migrate force 6`"},
+	}
+	for _, tc := range testCases {
+		require.Contains(t, dirtyError(tc.version).Error(), tc.expectedHint)
+	}
+}
@@ -0,0 +1,31 @@
+-- Only the default tenant can be kept once account IDs are globally unique again.
+DELETE FROM virtual_accounts WHERE tenant_id <> 'default';
+DELETE FROM account_transactions WHERE tenant_id <> 'default';
+DELETE FROM account_balances WHERE tenant_id <> 'default';
+DELETE FROM accounts WHERE tenant_id <> 'default';
+
+ALTER TABLE api_clients DROP COLUMN IF EXISTS tenant_id;
+
+ALTER TABLE virtual_accounts DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE account_transactions DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE account_balances DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE accounts DROP COLUMN IF EXISTS tenant_id;
+
+ALTER TABLE accounts
+    ADD PRIMARY KEY (id),
+    ADD CONSTRAINT account_id_unique UNIQUE (account_id);
+
+ALTER TABLE account_balances
+    ADD PRIMARY KEY (id),
+    ADD CONSTRAINT account_balance_unique UNIQUE (account_id),
+    ADD FOREIGN KEY (account_id) REFERENCES accounts (account_id);
+
+ALTER TABLE account_transactions
+    ADD FOREIGN KEY (account_src_id) REFERENCES accounts (account_id),
+    ADD FOREIGN KEY (account_dst_id) REFERENCES accounts (account_id);
+
+ALTER TABLE virtual_accounts
+    ADD PRIMARY KEY (virtual_account_no),
+    ADD FOREIGN KEY (account_id) REFERENCES accounts (account_id);
+
+DROP TABLE IF EXISTS tenants;
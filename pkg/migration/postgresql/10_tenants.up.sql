@@ -0,0 +1,54 @@
+CREATE TABLE IF NOT EXISTS tenants
+(
+    id VARCHAR(64) NOT NULL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+INSERT INTO tenants (id, name, created_at)
+VALUES ('default', 'Default tenant', NOW())
+ON CONFLICT DO NOTHING;
+
+-- Account IDs become unique per tenant, so every key referencing an account includes the tenant.
+ALTER TABLE virtual_accounts DROP CONSTRAINT IF EXISTS virtual_accounts_account_id_fkey;
+ALTER TABLE account_transactions DROP CONSTRAINT IF EXISTS account_transactions_account_src_id_fkey;
+ALTER TABLE account_transactions DROP CONSTRAINT IF EXISTS account_transactions_account_dst_id_fkey;
+ALTER TABLE account_balances DROP CONSTRAINT IF EXISTS account_balances_account_id_fkey;
+
+ALTER TABLE accounts
+    ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(64) NOT NULL DEFAULT 'default' REFERENCES tenants (id),
+    DROP CONSTRAINT IF EXISTS account_id_unique,
+    DROP CONSTRAINT IF EXISTS accounts_pkey,
+    ADD PRIMARY KEY (tenant_id, id),
+    ADD CONSTRAINT account_id_unique UNIQUE (tenant_id, account_id);
+
+ALTER TABLE account_balances
+    ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(64) NOT NULL DEFAULT 'default',
+    DROP CONSTRAINT IF EXISTS account_balance_unique,
+    DROP CONSTRAINT IF EXISTS account_balances_account_id_key,
+    DROP CONSTRAINT IF EXISTS account_balances_pkey,
+    ADD PRIMARY KEY (tenant_id, id),
+    ADD CONSTRAINT account_balance_unique UNIQUE (tenant_id, account_id),
+    ADD FOREIGN KEY (tenant_id, account_id) REFERENCES accounts (tenant_id, account_id);
+
+-- Both accounts of a transaction must belong to its tenant, so a transfer can never cross tenants.
+ALTER TABLE account_transactions
+    ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(64) NOT NULL DEFAULT 'default',
+    ADD FOREIGN KEY (tenant_id, account_src_id) REFERENCES accounts (tenant_id, account_id),
+    ADD FOREIGN KEY (tenant_id, account_dst_id) REFERENCES accounts (tenant_id, account_id);
+
+CREATE INDEX IF NOT EXISTS account_transactions_tenant_id_idx ON account_transactions (tenant_id, transaction_timestamp);
+
+ALTER TABLE virtual_accounts
+    ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(64) NOT NULL DEFAULT 'default',
+    DROP CONSTRAINT IF EXISTS virtual_accounts_pkey,
+    ADD PRIMARY KEY (tenant_id, virtual_account_no),
+    ADD FOREIGN KEY (tenant_id, account_id) REFERENCES accounts (tenant_id, account_id);
+
+ALTER TABLE api_clients
+    ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(64) REFERENCES tenants (id);
+
+ALTER TABLE accounts ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE account_balances ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE account_transactions ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE virtual_accounts ALTER COLUMN tenant_id DROP DEFAULT;
@@ -0,0 +1,2 @@
+ALTER TABLE account_balances
+    DROP COLUMN IF EXISTS allow_negative_balance;
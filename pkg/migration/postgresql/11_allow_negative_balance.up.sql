@@ -0,0 +1,8 @@
+-- AccountBalance.AllowNegativeBalance had no column, so every balance read defaulted to false and
+-- writing a balance failed. Settlement accounts are debited below zero by deposits.
+ALTER TABLE account_balances
+    ADD COLUMN IF NOT EXISTS allow_negative_balance BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE account_balances
+SET allow_negative_balance = TRUE
+WHERE account_id IN ('SETTLEMENT-CASHIN', 'SETTLEMENT-CASHOUT');
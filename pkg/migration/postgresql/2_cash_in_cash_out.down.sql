@@ -0,0 +1,8 @@
+DELETE FROM account_transactions WHERE transaction_type IN ('DEPOSIT', 'WITHDRAWAL');
+DELETE FROM account_balances WHERE id IN ('SETTLEMENT-CASHIN', 'SETTLEMENT-CASHOUT');
+DELETE FROM accounts WHERE id IN ('SETTLEMENT-CASHIN', 'SETTLEMENT-CASHOUT');
+
+ALTER TABLE account_transactions
+    DROP COLUMN IF EXISTS transaction_type,
+    DROP COLUMN IF EXISTS channel,
+    DROP COLUMN IF EXISTS reference;
@@ -0,0 +1,14 @@
+ALTER TABLE account_transactions
+    ADD COLUMN IF NOT EXISTS transaction_type VARCHAR(16) NOT NULL DEFAULT 'TRANSFER',
+    ADD COLUMN IF NOT EXISTS channel VARCHAR(32),
+    ADD COLUMN IF NOT EXISTS reference VARCHAR(64);
+
+INSERT INTO accounts (id, account_id, name, birth_date, gender, created_at)
+VALUES ('SETTLEMENT-CASHIN', 'SETTLEMENT-CASHIN', 'Cash-in settlement', '1970-01-01T00:00:00Z', true, NOW()),
+       ('SETTLEMENT-CASHOUT', 'SETTLEMENT-CASHOUT', 'Cash-out settlement', '1970-01-01T00:00:00Z', true, NOW())
+ON CONFLICT DO NOTHING;
+
+INSERT INTO account_balances (id, account_id, amount, created_at)
+VALUES ('SETTLEMENT-CASHIN', 'SETTLEMENT-CASHIN', 0.00, NOW()),
+       ('SETTLEMENT-CASHOUT', 'SETTLEMENT-CASHOUT', 0.00, NOW())
+ON CONFLICT DO NOTHING;
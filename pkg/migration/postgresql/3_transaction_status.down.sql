@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS account_transactions_status_idx;
+
+ALTER TABLE account_transactions
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS status_reason,
+    DROP COLUMN IF EXISTS settled_at;
@@ -0,0 +1,6 @@
+ALTER TABLE account_transactions
+    ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'SUCCESS',
+    ADD COLUMN IF NOT EXISTS status_reason VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS settled_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS account_transactions_status_idx ON account_transactions (status, transaction_timestamp);
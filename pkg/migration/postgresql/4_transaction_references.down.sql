@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS account_transactions_metadata_idx;
+DROP INDEX IF EXISTS account_transactions_external_reference_idx;
+
+ALTER TABLE account_transactions
+    DROP COLUMN IF EXISTS remark,
+    DROP COLUMN IF EXISTS external_reference,
+    DROP COLUMN IF EXISTS purpose_code,
+    DROP COLUMN IF EXISTS metadata;
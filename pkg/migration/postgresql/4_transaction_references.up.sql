@@ -0,0 +1,8 @@
+ALTER TABLE account_transactions
+    ADD COLUMN IF NOT EXISTS remark VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS external_reference VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS purpose_code VARCHAR(8),
+    ADD COLUMN IF NOT EXISTS metadata JSONB;
+
+CREATE INDEX IF NOT EXISTS account_transactions_external_reference_idx ON account_transactions (external_reference);
+CREATE INDEX IF NOT EXISTS account_transactions_metadata_idx ON account_transactions USING GIN (metadata);
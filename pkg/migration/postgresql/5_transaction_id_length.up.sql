@@ -0,0 +1 @@
+ALTER TABLE account_transactions ALTER COLUMN id TYPE VARCHAR(40);
@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS virtual_accounts
+(
+    virtual_account_no VARCHAR(40) NOT NULL PRIMARY KEY,
+    partner_service_id VARCHAR(8) NOT NULL,
+    customer_no VARCHAR(32) NOT NULL,
+    account_id VARCHAR(32) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    email VARCHAR(255),
+    phone VARCHAR(30),
+    trx_id VARCHAR(64),
+    trx_type VARCHAR(1) NOT NULL DEFAULT 'C',
+    total_amount DECIMAL(10, 2) NOT NULL DEFAULT 0.00,
+    currency VARCHAR(3) NOT NULL DEFAULT 'IDR',
+    expired_date TIMESTAMP WITH TIME ZONE,
+    status VARCHAR(16) NOT NULL DEFAULT 'ACTIVE',
+    inquiry_request_id VARCHAR(128),
+    payment_request_id VARCHAR(128),
+    payment_transaction_id VARCHAR(40),
+    paid_amount DECIMAL(10, 2),
+    paid_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE,
+
+    FOREIGN KEY (account_id) REFERENCES accounts(account_id),
+    FOREIGN KEY (payment_transaction_id) REFERENCES account_transactions(id)
+);
+
+CREATE INDEX IF NOT EXISTS virtual_accounts_account_id_idx ON virtual_accounts (account_id);
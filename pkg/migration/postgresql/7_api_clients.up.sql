@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS api_clients
+(
+    id VARCHAR(64) NOT NULL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    public_key TEXT,
+    client_secret VARCHAR(255),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE
+);
@@ -0,0 +1,2 @@
+ALTER TABLE api_clients
+    DROP COLUMN IF EXISTS scopes;
@@ -0,0 +1,2 @@
+ALTER TABLE api_clients
+    ADD COLUMN IF NOT EXISTS scopes JSONB;
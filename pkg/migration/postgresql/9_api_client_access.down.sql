@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS account_transactions_client_id_idx;
+
+ALTER TABLE account_transactions
+    DROP COLUMN IF EXISTS client_id;
+
+ALTER TABLE api_clients
+    DROP COLUMN IF EXISTS secret_hash,
+    DROP COLUMN IF EXISTS allowed_ips,
+    DROP COLUMN IF EXISTS enabled,
+    DROP COLUMN IF EXISTS rate_limit;
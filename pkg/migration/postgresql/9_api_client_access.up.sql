@@ -0,0 +1,10 @@
+ALTER TABLE api_clients
+    ADD COLUMN IF NOT EXISTS secret_hash VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS allowed_ips JSONB,
+    ADD COLUMN IF NOT EXISTS enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    ADD COLUMN IF NOT EXISTS rate_limit INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE account_transactions
+    ADD COLUMN IF NOT EXISTS client_id VARCHAR(64);
+
+CREATE INDEX IF NOT EXISTS account_transactions_client_id_idx ON account_transactions (client_id);
@@ -3,17 +3,68 @@ package model
 import "time"
 
 type AccountTransactionInfo struct {
-	ID                   string    `json:"id"`
-	Amount               float64   `json:"amount"`
-	AccountSrcID         string    `json:"accountSrcId"`
-	AccountSrcName       string    `json:"accountSrcName"`
-	AccountDstID         string    `json:"accountDstId"`
-	AccountDstName       string    `json:"accountDstName"`
-	TransactionTimestamp time.Time `json:"transactionTimestamp"`
+	ID                   string            `json:"id"`
+	Amount               float64           `json:"amount"`
+	Type                 string            `json:"type"`
+	Status               string            `json:"status"`
+	StatusRemark         string            `json:"statusRemark,omitempty"`
+	Channel              string            `json:"channel,omitempty"`
+	Reference            string            `json:"reference,omitempty"`
+	Remark               string            `json:"remark,omitempty"`
+	ExternalReference    string            `json:"externalReference,omitempty"`
+	PurposeCode          string            `json:"purposeCode,omitempty"`
+	Metadata             map[string]string `json:"metadata,omitempty"`
+	ClientID             string            `json:"clientId,omitempty"`
+	AccountSrcID         string            `json:"accountSrcId"`
+	AccountSrcName       string            `json:"accountSrcName"`
+	AccountDstID         string            `json:"accountDstId"`
+	AccountDstName       string            `json:"accountDstName"`
+	TransactionTimestamp time.Time         `json:"transactionTimestamp"`
+	SettledAt            *time.Time        `json:"settledAt,omitempty"`
 }
 
 type AccountFundTransfer struct {
-	AccountDstID string  `json:"accountDstId"`
-	AccountSrcID string  `json:"accountSrcId"`
-	Amount       float64 `json:"amount"`
+	AccountDstID      string            `json:"accountDstId" validate:"required,nefield=AccountSrcID" example:"0987654321"`
+	AccountSrcID      string            `json:"accountSrcId" validate:"required" example:"1234567890"`
+	Amount            float64           `json:"amount" validate:"gt=0" example:"150000"`
+	Remark            string            `json:"remark,omitempty" validate:"max=255" example:"Invoice 2024-001"`
+	ExternalReference string            `json:"externalReference,omitempty" validate:"max=64" example:"INV-2024-001"`
+	PurposeCode       string            `json:"purposeCode,omitempty" validate:"max=8" example:"01"`
+	Metadata          map[string]string `json:"metadata,omitempty"`
+}
+
+type AccountTransactionSearch struct {
+	AccountID         string
+	ExternalReference string
+	PurposeCode       string
+	Remark            string
+	Status            string
+	Type              string
+	ClientID          string
+	Metadata          map[string]string
+	From              *time.Time
+	To                *time.Time
+	Page              int
+	Size              int
+}
+
+type AccountTransactionPage struct {
+	Items []AccountTransactionInfo `json:"items"`
+	Page  int                      `json:"page"`
+	Size  int                      `json:"size"`
+	Total int64                    `json:"total"`
+}
+
+type AccountDeposit struct {
+	AccountID string  `json:"accountId" example:"1234567890"`
+	Amount    float64 `json:"amount" example:"500000"`
+	Channel   string  `json:"channel" example:"TELLER"`
+	Reference string  `json:"reference" example:"DEP-2024-001"`
+}
+
+type AccountWithdrawal struct {
+	AccountID string  `json:"accountId" example:"1234567890"`
+	Amount    float64 `json:"amount" example:"100000"`
+	Channel   string  `json:"channel" example:"ATM"`
+	Reference string  `json:"reference" example:"WDL-2024-001"`
 }
@@ -13,18 +13,18 @@ type AccountInfo struct {
 }
 
 type AccountRegister struct {
-	ID                   string `json:"id"`
-	Name                 string `json:"name"`
-	Address              string `json:"address"`
-	BirthDate            string `json:"birthDate"`
-	Gender               bool   `json:"gender"`
-	AllowNegativeBalance bool   `json:"allowNegativeBalance"`
+	ID                   string `json:"id" validate:"required,max=32" example:"1234567890"`
+	Name                 string `json:"name" validate:"required,max=50" example:"Siska"`
+	Address              string `json:"address" example:"Jl. Sudirman No. 1, Jakarta"`
+	BirthDate            string `json:"birthDate" validate:"required,date" example:"1996-03-11"`
+	Gender               bool   `json:"gender" example:"false"`
+	AllowNegativeBalance bool   `json:"allowNegativeBalance" example:"false"`
 }
 
 type AccountEdit struct {
-	Name                 *string `json:"name,omitempty"`
-	Address              *string `json:"address,omitempty"`
-	BirthDate            *string `json:"birthDate,omitempty"`
+	Name                 *string `json:"name,omitempty" validate:"omitnil,min=1,max=50" example:"Siska Putri"`
+	Address              *string `json:"address,omitempty" example:"Jl. Thamrin No. 2, Jakarta"`
+	BirthDate            *string `json:"birthDate,omitempty" validate:"omitnil,date"`
 	Gender               *bool   `json:"gender,omitempty"`
 	AllowNegativeBalance *bool   `json:"allowNegativeBalance,omitempty"`
 }
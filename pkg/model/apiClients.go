@@ -0,0 +1,49 @@
+package model
+
+import "time"
+
+type APIClientRegister struct {
+	ID   string `json:"clientId" validate:"required,max=64" example:"partner-app"`
+	Name string `json:"name" validate:"required,max=255" example:"Partner App"`
+	// TenantID binds the client to a tenant. Clients without one choose the tenant with X-TENANT-ID.
+	TenantID string `json:"tenantId,omitempty" validate:"max=64" example:"bank-a"`
+	// PublicKey is the PEM encoded RSA or ECDSA public key verifying token request signatures.
+	PublicKey string `json:"publicKey,omitempty"`
+	// ClientSecret is the key of the HMAC-SHA512 signatures of service requests.
+	ClientSecret string `json:"clientSecret,omitempty" validate:"max=255"`
+	// Scopes are the scopes the client's bearer tokens may be granted.
+	Scopes []string `json:"scopes,omitempty" example:"[\"accounts:read\",\"transfers:write\"]"`
+	// AllowedIPs are the IP addresses and CIDR ranges the client may call from, any when empty.
+	AllowedIPs []string `json:"allowedIps,omitempty" validate:"dive,ip|cidr" example:"[\"10.0.0.0/8\"]"`
+	// Enabled defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+	// RateLimit is the number of requests per minute the client may make, unlimited when 0.
+	RateLimit int `json:"rateLimit,omitempty" validate:"min=0" example:"600"`
+}
+
+type APIClientEdit struct {
+	Name         *string   `json:"name,omitempty" validate:"omitnil,min=1,max=255"`
+	TenantID     *string   `json:"tenantId,omitempty" validate:"omitnil,max=64"`
+	PublicKey    *string   `json:"publicKey,omitempty"`
+	ClientSecret *string   `json:"clientSecret,omitempty" validate:"omitnil,max=255"`
+	Scopes       *[]string `json:"scopes,omitempty"`
+	AllowedIPs   *[]string `json:"allowedIps,omitempty" validate:"omitnil,dive,ip|cidr"`
+	Enabled      *bool     `json:"enabled,omitempty"`
+	RateLimit    *int      `json:"rateLimit,omitempty" validate:"omitnil,min=0"`
+}
+
+// APIClientInfo describes an API client. Its API secret is only returned when the client is created
+// or the secret is rotated, mockva keeps a hash of it.
+type APIClientInfo struct {
+	ID              string    `json:"clientId"`
+	Name            string    `json:"name"`
+	TenantID        string    `json:"tenantId,omitempty"`
+	PublicKey       string    `json:"publicKey,omitempty"`
+	HasClientSecret bool      `json:"hasClientSecret"`
+	Scopes          []string  `json:"scopes"`
+	AllowedIPs      []string  `json:"allowedIps"`
+	Enabled         bool      `json:"enabled"`
+	RateLimit       int       `json:"rateLimit"`
+	CreatedAt       time.Time `json:"createdAt"`
+	APISecret       string    `json:"apiSecret,omitempty"`
+}
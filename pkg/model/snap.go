@@ -0,0 +1,118 @@
+package model
+
+// SNAP BI transfer VA request and response bodies. Field names follow the SNAP specification,
+// so SNAP clients can be pointed at mockva unchanged.
+
+// SnapAmount is a SNAP money amount, e.g. {"value": "10000.00", "currency": "IDR"}.
+type SnapAmount struct {
+	Value    string `json:"value" validate:"required" example:"10000.00"`
+	Currency string `json:"currency" validate:"required,len=3" example:"IDR"`
+}
+
+// SnapReason is a bilingual reason of a SNAP inquiry or payment outcome.
+type SnapReason struct {
+	English   string `json:"english"`
+	Indonesia string `json:"indonesia"`
+}
+
+// SnapResponse is the envelope of every SNAP response.
+type SnapResponse struct {
+	ResponseCode       string `json:"responseCode"`
+	ResponseMessage    string `json:"responseMessage"`
+	VirtualAccountData any    `json:"virtualAccountData,omitempty"`
+}
+
+// SnapVirtualAccount is the body of create-va and update-va requests and their response data.
+type SnapVirtualAccount struct {
+	PartnerServiceID      string         `json:"partnerServiceId" validate:"required,max=8" example:"   12345"`
+	CustomerNo            string         `json:"customerNo" validate:"required,max=32" example:"123456789012"`
+	VirtualAccountNo      string         `json:"virtualAccountNo" validate:"required,max=40" example:"   12345123456789012"`
+	VirtualAccountName    string         `json:"virtualAccountName,omitempty" validate:"max=255" example:"Siska"`
+	VirtualAccountEmail   string         `json:"virtualAccountEmail,omitempty"`
+	VirtualAccountPhone   string         `json:"virtualAccountPhone,omitempty"`
+	TrxID                 string         `json:"trxId,omitempty" example:"TRX-2024-001"`
+	TotalAmount           *SnapAmount    `json:"totalAmount,omitempty" validate:"required"`
+	VirtualAccountTrxType string         `json:"virtualAccountTrxType,omitempty" validate:"omitempty,oneof=C O"`
+	ExpiredDate           string         `json:"expiredDate,omitempty" example:"2024-12-31T23:59:59+07:00"`
+	AdditionalInfo        map[string]any `json:"additionalInfo,omitempty"`
+}
+
+// SnapDeleteVirtualAccount is the body of delete-va requests and their response data.
+type SnapDeleteVirtualAccount struct {
+	PartnerServiceID string         `json:"partnerServiceId" validate:"required,max=8"`
+	CustomerNo       string         `json:"customerNo" validate:"required,max=32"`
+	VirtualAccountNo string         `json:"virtualAccountNo" validate:"required,max=40"`
+	TrxID            string         `json:"trxId,omitempty"`
+	AdditionalInfo   map[string]any `json:"additionalInfo,omitempty"`
+}
+
+type SnapInquiryRequest struct {
+	PartnerServiceID string         `json:"partnerServiceId" validate:"required,max=8" example:"   12345"`
+	CustomerNo       string         `json:"customerNo" validate:"required,max=32" example:"123456789012"`
+	VirtualAccountNo string         `json:"virtualAccountNo" validate:"required,max=40" example:"   12345123456789012"`
+	TrxDateInit      string         `json:"trxDateInit,omitempty"`
+	ChannelCode      int            `json:"channelCode,omitempty"`
+	Language         string         `json:"language,omitempty"`
+	Amount           *SnapAmount    `json:"amount,omitempty"`
+	InquiryRequestID string         `json:"inquiryRequestId" validate:"required,max=128" example:"INQ-2024-001"`
+	AdditionalInfo   map[string]any `json:"additionalInfo,omitempty"`
+}
+
+type SnapInquiryData struct {
+	InquiryStatus         string         `json:"inquiryStatus"`
+	InquiryReason         SnapReason     `json:"inquiryReason"`
+	PartnerServiceID      string         `json:"partnerServiceId"`
+	CustomerNo            string         `json:"customerNo"`
+	VirtualAccountNo      string         `json:"virtualAccountNo"`
+	VirtualAccountName    string         `json:"virtualAccountName"`
+	VirtualAccountEmail   string         `json:"virtualAccountEmail,omitempty"`
+	VirtualAccountPhone   string         `json:"virtualAccountPhone,omitempty"`
+	InquiryRequestID      string         `json:"inquiryRequestId"`
+	TotalAmount           SnapAmount     `json:"totalAmount"`
+	VirtualAccountTrxType string         `json:"virtualAccountTrxType"`
+	AdditionalInfo        map[string]any `json:"additionalInfo,omitempty"`
+}
+
+type SnapPaymentRequest struct {
+	PartnerServiceID   string         `json:"partnerServiceId" validate:"required,max=8"`
+	CustomerNo         string         `json:"customerNo" validate:"required,max=32"`
+	VirtualAccountNo   string         `json:"virtualAccountNo" validate:"required,max=40"`
+	VirtualAccountName string         `json:"virtualAccountName,omitempty"`
+	TrxID              string         `json:"trxId,omitempty"`
+	PaymentRequestID   string         `json:"paymentRequestId" validate:"required,max=64"`
+	ChannelCode        int            `json:"channelCode,omitempty"`
+	HashedSourceAcctNo string         `json:"hashedSourceAccountNo,omitempty"`
+	SourceBankCode     string         `json:"sourceBankCode,omitempty"`
+	PaidAmount         *SnapAmount    `json:"paidAmount" validate:"required"`
+	TotalAmount        *SnapAmount    `json:"totalAmount,omitempty"`
+	TrxDateTime        string         `json:"trxDateTime,omitempty"`
+	ReferenceNo        string         `json:"referenceNo,omitempty"`
+	FlagAdvise         string         `json:"flagAdvise,omitempty"`
+	AdditionalInfo     map[string]any `json:"additionalInfo,omitempty"`
+}
+
+// SnapPaymentData is the response data of payment and inquiry-status requests.
+type SnapPaymentData struct {
+	PaymentFlagReason  SnapReason     `json:"paymentFlagReason"`
+	PartnerServiceID   string         `json:"partnerServiceId"`
+	CustomerNo         string         `json:"customerNo"`
+	VirtualAccountNo   string         `json:"virtualAccountNo"`
+	VirtualAccountName string         `json:"virtualAccountName"`
+	InquiryRequestID   string         `json:"inquiryRequestId,omitempty"`
+	PaymentRequestID   string         `json:"paymentRequestId"`
+	PaidAmount         SnapAmount     `json:"paidAmount"`
+	TotalAmount        SnapAmount     `json:"totalAmount"`
+	TrxDateTime        string         `json:"trxDateTime"`
+	ReferenceNo        string         `json:"referenceNo"`
+	PaymentFlagStatus  string         `json:"paymentFlagStatus"`
+	AdditionalInfo     map[string]any `json:"additionalInfo,omitempty"`
+}
+
+type SnapInquiryStatusRequest struct {
+	PartnerServiceID string         `json:"partnerServiceId" validate:"required,max=8"`
+	CustomerNo       string         `json:"customerNo" validate:"required,max=32"`
+	VirtualAccountNo string         `json:"virtualAccountNo" validate:"required,max=40"`
+	InquiryRequestID string         `json:"inquiryRequestId,omitempty"`
+	PaymentRequestID string         `json:"paymentRequestId,omitempty"`
+	AdditionalInfo   map[string]any `json:"additionalInfo,omitempty"`
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+type TenantRegister struct {
+	ID   string `json:"tenantId" validate:"required,max=64" example:"bank-a"`
+	Name string `json:"name" validate:"required,max=255" example:"Bank A"`
+}
+
+type TenantInfo struct {
+	ID        string    `json:"tenantId"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+}
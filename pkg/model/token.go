@@ -0,0 +1,25 @@
+package model
+
+// TokenResponse is the RFC 6749 token endpoint response.
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+// SnapAccessTokenRequest is the body of SNAP B2B access token requests.
+type SnapAccessTokenRequest struct {
+	GrantType string `json:"grantType" validate:"required,eq=client_credentials"`
+}
+
+// SnapAccessTokenResponse is the SNAP B2B access token response. Unlike the other SNAP responses,
+// its data is not wrapped in virtualAccountData.
+type SnapAccessTokenResponse struct {
+	ResponseCode    string `json:"responseCode"`
+	ResponseMessage string `json:"responseMessage"`
+	AccessToken     string `json:"accessToken,omitempty"`
+	TokenType       string `json:"tokenType,omitempty"`
+	ExpiresIn       string `json:"expiresIn,omitempty"`
+}
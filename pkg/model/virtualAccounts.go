@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// VirtualAccountRegister holds the bill details used to create or update a virtual account.
+type VirtualAccountRegister struct {
+	PartnerServiceID string
+	CustomerNo       string
+	// VirtualAccountNo is optional. When set it must equal the partner service ID followed by the customer number.
+	VirtualAccountNo string
+	Name             string
+	Email            string
+	Phone            string
+	TrxID            string
+	TrxType          string
+	TotalAmount      float64
+	Currency         string
+	ExpiredDate      *time.Time
+}
+
+// VirtualAccountPayment holds the details of a payment made to a virtual account bill.
+type VirtualAccountPayment struct {
+	PartnerServiceID string
+	CustomerNo       string
+	VirtualAccountNo string
+	PaymentRequestID string
+	PaidAmount       float64
+	Currency         string
+}
@@ -0,0 +1,137 @@
+// Package ratelimit provides the token buckets and concurrency counters throttling the requests
+// made to mockva, keyed by whatever is being limited: the whole instance, a route or an API client.
+package ratelimit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Buckets are token buckets refilled at a number of requests per minute, one per key. A bucket holds
+// up to a minute's worth of requests, so a client may burst its whole allowance at once.
+type Buckets struct {
+	mu    sync.Mutex
+	byKey map[string]*bucket
+}
+
+// bucket is the token bucket of a key, along with the limit it was created for so it can be
+// replaced when the limit changes.
+type bucket struct {
+	perMinute int
+	limiter   *rate.Limiter
+}
+
+// NewBuckets creates empty Buckets.
+func NewBuckets() *Buckets {
+	return &Buckets{byKey: map[string]*bucket{}}
+}
+
+// Take takes a request from the bucket of key, created with perMinute requests per minute if needed.
+// Parameters:
+//   - key: What is being limited
+//   - perMinute: The number of requests per minute allowed for key, unlimited when 0 or less
+//
+// Returns:
+//   - time.Duration: How long to wait before a request is allowed again, 0 when this one is
+func (b *Buckets) Take(key string, perMinute int) time.Duration {
+	if perMinute <= 0 {
+		return 0
+	}
+	b.mu.Lock()
+	current, ok := b.byKey[key]
+	if !ok || current.perMinute != perMinute {
+		current = &bucket{
+			perMinute: perMinute,
+			limiter:   rate.NewLimiter(rate.Limit(float64(perMinute)/60), perMinute),
+		}
+		b.byKey[key] = current
+	}
+	b.mu.Unlock()
+
+	reservation := current.limiter.Reserve()
+	if delay := reservation.Delay(); delay > 0 {
+		reservation.Cancel()
+		return delay
+	}
+	return 0
+}
+
+// Concurrency counts the requests in flight of each key.
+type Concurrency struct {
+	mu       sync.Mutex
+	inFlight map[string]int
+}
+
+// NewConcurrency creates a Concurrency with no request in flight.
+func NewConcurrency() *Concurrency {
+	return &Concurrency{inFlight: map[string]int{}}
+}
+
+// Acquire counts a request of key in flight unless key already has limit requests in flight.
+// Every successful Acquire must be followed by a Release once the request completes.
+// Parameters:
+//   - key: What is being limited
+//   - limit: The number of requests key may have in flight, unlimited when 0 or less
+//
+// Returns:
+//   - bool: Whether the request may proceed
+func (c *Concurrency) Acquire(key string, limit int) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if limit > 0 && c.inFlight[key] >= limit {
+		return false
+	}
+	c.inFlight[key]++
+	return true
+}
+
+// Release stops counting a request of key acquired with Acquire.
+func (c *Concurrency) Release(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.inFlight[key] <= 1 {
+		delete(c.inFlight, key)
+		return
+	}
+	c.inFlight[key]--
+}
+
+// RouteKey identifies a route in route limits, e.g. "POST /mockva/accountTransactions/transfer".
+func RouteKey(method, path string) string {
+	return strings.ToUpper(method) + " " + path
+}
+
+// ParseRouteLimits parses the limits of routes written as comma separated "METHOD path=perMinute"
+// entries, e.g. "POST /mockva/accountTransactions/transfer=600,GET /mockva/accounts/{accountId}=1200".
+// Paths are the route paths as registered, path parameters included.
+// Parameters:
+//   - value: The route limits, empty for none
+//
+// Returns:
+//   - map[string]int: The requests per minute of each route, keyed by RouteKey
+//   - error: If an entry is malformed
+func ParseRouteLimits(value string) (map[string]int, error) {
+	limits := map[string]int{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		route, perMinute, found := strings.Cut(entry, "=")
+		method, path, hasPath := strings.Cut(strings.TrimSpace(route), " ")
+		if !found || !hasPath || strings.TrimSpace(path) == "" {
+			return nil, fmt.Errorf("route limit %q is not METHOD path=perMinute", entry)
+		}
+		limit, err := strconv.Atoi(strings.TrimSpace(perMinute))
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("route limit %q must be a non-negative number of requests per minute", entry)
+		}
+		limits[RouteKey(method, strings.TrimSpace(path))] = limit
+	}
+	return limits, nil
+}
@@ -0,0 +1,53 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuckets_Take(t *testing.T) {
+	buckets := NewBuckets()
+
+	assertions := require.New(t)
+	assertions.Zero(buckets.Take("client-001", 2))
+	assertions.Zero(buckets.Take("client-001", 2))
+	retryAfter := buckets.Take("client-001", 2)
+	assertions.InDelta(30*time.Second, retryAfter, float64(time.Second), "a token is added every 30 seconds")
+	assertions.Zero(buckets.Take("client-002", 2), "every key has its own bucket")
+	assertions.Zero(buckets.Take("client-001", 0), "0 is unlimited")
+	assertions.Zero(buckets.Take("client-001", 3), "a new limit starts a new bucket")
+}
+
+func TestConcurrency(t *testing.T) {
+	concurrency := NewConcurrency()
+
+	assertions := require.New(t)
+	assertions.True(concurrency.Acquire("client-001", 1))
+	assertions.False(concurrency.Acquire("client-001", 1))
+	assertions.True(concurrency.Acquire("client-002", 1))
+	concurrency.Release("client-001")
+	assertions.True(concurrency.Acquire("client-001", 1))
+	assertions.True(concurrency.Acquire("client-001", 0), "0 is unlimited")
+}
+
+func TestParseRouteLimits(t *testing.T) {
+	limits, err := ParseRouteLimits("POST /mockva/accountTransactions/transfer=600, get /mockva/accounts/{accountId}=1200")
+
+	assertions := require.New(t)
+	assertions.Nil(err)
+	assertions.Equal(map[string]int{
+		"POST /mockva/accountTransactions/transfer": 600,
+		"GET /mockva/accounts/{accountId}":          1200,
+	}, limits)
+
+	limits, err = ParseRouteLimits("")
+	assertions.Nil(err)
+	assertions.Empty(limits)
+
+	for _, invalid := range []string{"/mockva/accounts=10", "GET /mockva/accounts", "GET /mockva/accounts=many", "GET /mockva/accounts=-1"} {
+		_, err = ParseRouteLimits(invalid)
+		assertions.NotNil(err, invalid)
+	}
+}
@@ -20,13 +20,15 @@ type AccountRepository interface {
 	//   - error: If the account is not found or a database error occurs
 	FindByID(ctx context.Context, accountId string) (*domain.Account, error)
 
-	// Save persists a new account to the database.
+	// Save persists a new account and its opening balance in one database transaction, so that
+	// every account can be credited and debited as soon as it exists.
 	// Parameters:
 	//   - ctx: The request context for cancellation and timeouts
 	//   - newAccount: The account entity to persist
+	//   - balance: The opening balance of the account
 	// Returns:
 	//   - error: If the account already exists or a database error occurs
-	Save(ctx context.Context, newAccount *domain.Account) error
+	Save(ctx context.Context, newAccount *domain.Account, balance *domain.AccountBalance) error
 
 	// Update modifies an existing account's information in the database.
 	// Parameters:
@@ -37,15 +39,16 @@ type AccountRepository interface {
 	//   - error: If the account is not found or a database error occurs
 	Update(ctx context.Context, updatedAccount *domain.Account) (*domain.Account, error)
 
-	// FindAndLockAccountBalance retrieves an account balance with a pessimistic lock.
-	// This method should be used within transactions to prevent concurrent balance modifications.
+	// FindAndLockAccountBalance retrieves an account balance with a pessimistic lock held until tx ends.
+	// This method must be called within an active database transaction.
 	// Parameters:
 	//   - ctx: The request context for cancellation and timeouts
 	//   - accountID: The unique account identifier
+	//   - tx: The GORM transaction context
 	// Returns:
 	//   - *domain.AccountBalance: The account balance with an active row lock
 	//   - error: If the account is not found or a database error occurs
-	FindAndLockAccountBalance(ctx context.Context, accountID string) (*domain.AccountBalance, error)
+	FindAndLockAccountBalance(ctx context.Context, accountID string, tx *gorm.DB) (*domain.AccountBalance, error)
 
 	// UpdateBalance updates the account balance within the provided transaction context.
 	// This method must be called within an active database transaction.
@@ -57,4 +60,13 @@ type AccountRepository interface {
 	//   - *domain.AccountBalance: The updated balance
 	//   - error: If the account is not found or a database error occurs
 	UpdateBalance(ctx context.Context, accountBalance *domain.AccountBalance, tx *gorm.DB) (*domain.AccountBalance, error)
+
+	// UpdateAllowNegativeBalance sets whether the balance of an account may go below zero, leaving the amount as is.
+	// Parameters:
+	//   - ctx: The request context for cancellation and timeouts
+	//   - accountID: The unique account identifier
+	//   - allowNegativeBalance: Whether transfers and withdrawals may overdraw the account
+	// Returns:
+	//   - error: If the account is not found or a database error occurs
+	UpdateAllowNegativeBalance(ctx context.Context, accountID string, allowNegativeBalance bool) error
 }
@@ -3,16 +3,89 @@ package repository
 //go:generate mockgen -destination=mock/mockAccountTransactionRepository.go -package=mock github.com/mrth1995/go-mockva/pkg/repository AccountTransactionRepository
 
 import (
+	"context"
+	"time"
+
 	"github.com/mrth1995/go-mockva/pkg/domain"
 	"gorm.io/gorm"
 )
 
+// AccountTransactionFilter narrows down the transactions returned by AccountTransactionRepository.Search.
+// Empty fields are ignored.
+type AccountTransactionFilter struct {
+	// AccountID matches either the source or the destination account.
+	AccountID         string
+	ExternalReference string
+	PurposeCode       string
+	// Remark matches transactions whose remark contains the value, case-insensitively.
+	Remark string
+	Status domain.TransactionStatus
+	Type   domain.TransactionType
+	// ClientID matches transactions created by the API client.
+	ClientID string
+	// Metadata matches transactions whose metadata contains every given key-value pair.
+	Metadata map[string]string
+	From     *time.Time
+	To       *time.Time
+	Offset   int
+	Limit    int
+}
+
 type AccountTransactionRepository interface {
 	// Save persists an AccountTransaction within the provided transaction context.
 	// Parameters:
-	//   - trx: The AccountTransaction to save
+	//   - ctx: The request context for cancellation and timeouts
+	//   - trx: The AccountTransaction to save, its TenantID must be set
 	//   - tx: The GORM transaction context
 	// Returns:
 	//   - error: If the operation fails
-	Save(trx *domain.AccountTransaction, tx *gorm.DB) error
+	Save(ctx context.Context, trx *domain.AccountTransaction, tx *gorm.DB) error
+
+	// Update persists the status changes of an existing AccountTransaction within the provided transaction context.
+	// Parameters:
+	//   - ctx: The request context for cancellation and timeouts
+	//   - trx: The AccountTransaction to update, only matched within its tenant
+	//   - tx: The GORM transaction context
+	// Returns:
+	//   - error: If the operation fails
+	Update(ctx context.Context, trx *domain.AccountTransaction, tx *gorm.DB) error
+
+	// FindByID retrieves an AccountTransaction by its unique identifier.
+	// Parameters:
+	//   - ctx: The request context for cancellation and timeouts
+	//   - id: The unique transaction identifier
+	// Returns:
+	//   - *domain.AccountTransaction: The transaction if found
+	//   - error: If the transaction is not found or a database error occurs
+	FindByID(ctx context.Context, id string) (*domain.AccountTransaction, error)
+
+	// FindAndLockByID retrieves an AccountTransaction with a pessimistic lock within the provided transaction context.
+	// Parameters:
+	//   - ctx: The request context for cancellation and timeouts
+	//   - id: The unique transaction identifier
+	//   - tx: The GORM transaction context
+	// Returns:
+	//   - *domain.AccountTransaction: The transaction with an active row lock
+	//   - error: If the transaction is not found or a database error occurs
+	FindAndLockByID(ctx context.Context, id string, tx *gorm.DB) (*domain.AccountTransaction, error)
+
+	// FindPendingBefore retrieves PENDING transactions of every tenant created at or before the given time, oldest first.
+	// Parameters:
+	//   - ctx: The request context for cancellation and timeouts
+	//   - before: Only transactions with a timestamp at or before this time are returned
+	//   - limit: The maximum number of transactions to return
+	// Returns:
+	//   - []domain.AccountTransaction: The pending transactions
+	//   - error: If a database error occurs
+	FindPendingBefore(ctx context.Context, before time.Time, limit int) ([]domain.AccountTransaction, error)
+
+	// Search retrieves transactions matching the filter, newest first.
+	// Parameters:
+	//   - ctx: The request context for cancellation and timeouts
+	//   - filter: The search criteria and pagination
+	// Returns:
+	//   - []domain.AccountTransaction: The matching transactions within the requested page
+	//   - int64: The total number of matching transactions
+	//   - error: If a database error occurs
+	Search(ctx context.Context, filter *AccountTransactionFilter) ([]domain.AccountTransaction, int64, error)
 }
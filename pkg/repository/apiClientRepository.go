@@ -0,0 +1,37 @@
+package repository
+
+//go:generate mockgen -destination=mock/mockAPIClientRepository.go -package=mock github.com/mrth1995/go-mockva/pkg/repository APIClientRepository
+
+import (
+	"context"
+
+	"github.com/mrth1995/go-mockva/pkg/domain"
+)
+
+// APIClientRepository defines the interface for API client data persistence operations.
+type APIClientRepository interface {
+	// FindByID retrieves an API client by its unique identifier.
+	// Parameters:
+	//   - ctx: The request context for cancellation and timeouts
+	//   - id: The client ID
+	// Returns:
+	//   - *domain.APIClient: The API client if found
+	//   - error: If the API client is not found or a database error occurs
+	FindByID(ctx context.Context, id string) (*domain.APIClient, error)
+
+	// FindAll retrieves every API client ordered by client ID.
+	// Parameters:
+	//   - ctx: The request context for cancellation and timeouts
+	// Returns:
+	//   - []domain.APIClient: The API clients
+	//   - error: If a database error occurs
+	FindAll(ctx context.Context) ([]domain.APIClient, error)
+
+	// Save creates the API client or replaces an existing one with the same ID.
+	// Parameters:
+	//   - ctx: The request context for cancellation and timeouts
+	//   - client: The API client to persist
+	// Returns:
+	//   - error: If a database error occurs
+	Save(ctx context.Context, client *domain.APIClient) error
+}
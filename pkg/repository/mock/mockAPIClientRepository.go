@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/mrth1995/go-mockva/pkg/repository (interfaces: APIClientRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock/mockAPIClientRepository.go -package=mock github.com/mrth1995/go-mockva/pkg/repository APIClientRepository
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/mrth1995/go-mockva/pkg/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAPIClientRepository is a mock of APIClientRepository interface.
+type MockAPIClientRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIClientRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIClientRepositoryMockRecorder is the mock recorder for MockAPIClientRepository.
+type MockAPIClientRepositoryMockRecorder struct {
+	mock *MockAPIClientRepository
+}
+
+// NewMockAPIClientRepository creates a new mock instance.
+func NewMockAPIClientRepository(ctrl *gomock.Controller) *MockAPIClientRepository {
+	mock := &MockAPIClientRepository{ctrl: ctrl}
+	mock.recorder = &MockAPIClientRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIClientRepository) EXPECT() *MockAPIClientRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockAPIClientRepository) FindAll(ctx context.Context) ([]domain.APIClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]domain.APIClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockAPIClientRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockAPIClientRepository)(nil).FindAll), ctx)
+}
+
+// FindByID mocks base method.
+func (m *MockAPIClientRepository) FindByID(ctx context.Context, id string) (*domain.APIClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*domain.APIClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockAPIClientRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockAPIClientRepository)(nil).FindByID), ctx, id)
+}
+
+// Save mocks base method.
+func (m *MockAPIClientRepository) Save(ctx context.Context, client *domain.APIClient) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, client)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockAPIClientRepositoryMockRecorder) Save(ctx, client any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAPIClientRepository)(nil).Save), ctx, client)
+}
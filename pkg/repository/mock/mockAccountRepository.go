@@ -43,18 +43,18 @@ func (m *MockAccountRepository) EXPECT() *MockAccountRepositoryMockRecorder {
 }
 
 // FindAndLockAccountBalance mocks base method.
-func (m *MockAccountRepository) FindAndLockAccountBalance(ctx context.Context, accountID string) (*domain.AccountBalance, error) {
+func (m *MockAccountRepository) FindAndLockAccountBalance(ctx context.Context, accountID string, tx *gorm.DB) (*domain.AccountBalance, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAndLockAccountBalance", ctx, accountID)
+	ret := m.ctrl.Call(m, "FindAndLockAccountBalance", ctx, accountID, tx)
 	ret0, _ := ret[0].(*domain.AccountBalance)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindAndLockAccountBalance indicates an expected call of FindAndLockAccountBalance.
-func (mr *MockAccountRepositoryMockRecorder) FindAndLockAccountBalance(ctx, accountID any) *gomock.Call {
+func (mr *MockAccountRepositoryMockRecorder) FindAndLockAccountBalance(ctx, accountID, tx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAndLockAccountBalance", reflect.TypeOf((*MockAccountRepository)(nil).FindAndLockAccountBalance), ctx, accountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAndLockAccountBalance", reflect.TypeOf((*MockAccountRepository)(nil).FindAndLockAccountBalance), ctx, accountID, tx)
 }
 
 // FindByID mocks base method.
@@ -73,17 +73,17 @@ func (mr *MockAccountRepositoryMockRecorder) FindByID(ctx, accountId any) *gomoc
 }
 
 // Save mocks base method.
-func (m *MockAccountRepository) Save(ctx context.Context, newAccount *domain.Account) error {
+func (m *MockAccountRepository) Save(ctx context.Context, newAccount *domain.Account, balance *domain.AccountBalance) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Save", ctx, newAccount)
+	ret := m.ctrl.Call(m, "Save", ctx, newAccount, balance)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Save indicates an expected call of Save.
-func (mr *MockAccountRepositoryMockRecorder) Save(ctx, newAccount any) *gomock.Call {
+func (mr *MockAccountRepositoryMockRecorder) Save(ctx, newAccount, balance any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAccountRepository)(nil).Save), ctx, newAccount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAccountRepository)(nil).Save), ctx, newAccount, balance)
 }
 
 // Update mocks base method.
@@ -101,6 +101,20 @@ func (mr *MockAccountRepositoryMockRecorder) Update(ctx, updatedAccount any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAccountRepository)(nil).Update), ctx, updatedAccount)
 }
 
+// UpdateAllowNegativeBalance mocks base method.
+func (m *MockAccountRepository) UpdateAllowNegativeBalance(ctx context.Context, accountID string, allowNegativeBalance bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAllowNegativeBalance", ctx, accountID, allowNegativeBalance)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAllowNegativeBalance indicates an expected call of UpdateAllowNegativeBalance.
+func (mr *MockAccountRepositoryMockRecorder) UpdateAllowNegativeBalance(ctx, accountID, allowNegativeBalance any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAllowNegativeBalance", reflect.TypeOf((*MockAccountRepository)(nil).UpdateAllowNegativeBalance), ctx, accountID, allowNegativeBalance)
+}
+
 // UpdateBalance mocks base method.
 func (m *MockAccountRepository) UpdateBalance(ctx context.Context, accountBalance *domain.AccountBalance, tx *gorm.DB) (*domain.AccountBalance, error) {
 	m.ctrl.T.Helper()
@@ -10,9 +10,12 @@
 package mock
 
 import (
+	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/mrth1995/go-mockva/pkg/domain"
+	repository "github.com/mrth1995/go-mockva/pkg/repository"
 	gomock "go.uber.org/mock/gomock"
 	gorm "gorm.io/gorm"
 )
@@ -41,16 +44,91 @@ func (m *MockAccountTransactionRepository) EXPECT() *MockAccountTransactionRepos
 	return m.recorder
 }
 
+// FindAndLockByID mocks base method.
+func (m *MockAccountTransactionRepository) FindAndLockByID(ctx context.Context, id string, tx *gorm.DB) (*domain.AccountTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAndLockByID", ctx, id, tx)
+	ret0, _ := ret[0].(*domain.AccountTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAndLockByID indicates an expected call of FindAndLockByID.
+func (mr *MockAccountTransactionRepositoryMockRecorder) FindAndLockByID(ctx, id, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAndLockByID", reflect.TypeOf((*MockAccountTransactionRepository)(nil).FindAndLockByID), ctx, id, tx)
+}
+
+// FindByID mocks base method.
+func (m *MockAccountTransactionRepository) FindByID(ctx context.Context, id string) (*domain.AccountTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*domain.AccountTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockAccountTransactionRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockAccountTransactionRepository)(nil).FindByID), ctx, id)
+}
+
+// FindPendingBefore mocks base method.
+func (m *MockAccountTransactionRepository) FindPendingBefore(ctx context.Context, before time.Time, limit int) ([]domain.AccountTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPendingBefore", ctx, before, limit)
+	ret0, _ := ret[0].([]domain.AccountTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPendingBefore indicates an expected call of FindPendingBefore.
+func (mr *MockAccountTransactionRepositoryMockRecorder) FindPendingBefore(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingBefore", reflect.TypeOf((*MockAccountTransactionRepository)(nil).FindPendingBefore), ctx, before, limit)
+}
+
 // Save mocks base method.
-func (m *MockAccountTransactionRepository) Save(trx *domain.AccountTransaction, tx *gorm.DB) error {
+func (m *MockAccountTransactionRepository) Save(ctx context.Context, trx *domain.AccountTransaction, tx *gorm.DB) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Save", trx, tx)
+	ret := m.ctrl.Call(m, "Save", ctx, trx, tx)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Save indicates an expected call of Save.
-func (mr *MockAccountTransactionRepositoryMockRecorder) Save(trx, tx any) *gomock.Call {
+func (mr *MockAccountTransactionRepositoryMockRecorder) Save(ctx, trx, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAccountTransactionRepository)(nil).Save), ctx, trx, tx)
+}
+
+// Search mocks base method.
+func (m *MockAccountTransactionRepository) Search(ctx context.Context, filter *repository.AccountTransactionFilter) ([]domain.AccountTransaction, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter)
+	ret0, _ := ret[0].([]domain.AccountTransaction)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockAccountTransactionRepositoryMockRecorder) Search(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockAccountTransactionRepository)(nil).Search), ctx, filter)
+}
+
+// Update mocks base method.
+func (m *MockAccountTransactionRepository) Update(ctx context.Context, trx *domain.AccountTransaction, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, trx, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAccountTransactionRepositoryMockRecorder) Update(ctx, trx, tx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAccountTransactionRepository)(nil).Save), trx, tx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAccountTransactionRepository)(nil).Update), ctx, trx, tx)
 }
@@ -10,6 +10,7 @@
 package mock
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -41,15 +42,15 @@ func (m *MockDBTransactionManager) EXPECT() *MockDBTransactionManagerMockRecorde
 }
 
 // Transaction mocks base method.
-func (m *MockDBTransactionManager) Transaction(fc func(*gorm.DB) error) error {
+func (m *MockDBTransactionManager) Transaction(ctx context.Context, fc func(*gorm.DB) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Transaction", fc)
+	ret := m.ctrl.Call(m, "Transaction", ctx, fc)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Transaction indicates an expected call of Transaction.
-func (mr *MockDBTransactionManagerMockRecorder) Transaction(fc any) *gomock.Call {
+func (mr *MockDBTransactionManagerMockRecorder) Transaction(ctx, fc any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockDBTransactionManager)(nil).Transaction), fc)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockDBTransactionManager)(nil).Transaction), ctx, fc)
 }
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/mrth1995/go-mockva/pkg/repository (interfaces: VirtualAccountRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock/mockVirtualAccountRepository.go -package=mock github.com/mrth1995/go-mockva/pkg/repository VirtualAccountRepository
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/mrth1995/go-mockva/pkg/domain"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockVirtualAccountRepository is a mock of VirtualAccountRepository interface.
+type MockVirtualAccountRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVirtualAccountRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockVirtualAccountRepositoryMockRecorder is the mock recorder for MockVirtualAccountRepository.
+type MockVirtualAccountRepositoryMockRecorder struct {
+	mock *MockVirtualAccountRepository
+}
+
+// NewMockVirtualAccountRepository creates a new mock instance.
+func NewMockVirtualAccountRepository(ctrl *gomock.Controller) *MockVirtualAccountRepository {
+	mock := &MockVirtualAccountRepository{ctrl: ctrl}
+	mock.recorder = &MockVirtualAccountRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVirtualAccountRepository) EXPECT() *MockVirtualAccountRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAndLockByNo mocks base method.
+func (m *MockVirtualAccountRepository) FindAndLockByNo(ctx context.Context, virtualAccountNo string, tx *gorm.DB) (*domain.VirtualAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAndLockByNo", ctx, virtualAccountNo, tx)
+	ret0, _ := ret[0].(*domain.VirtualAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAndLockByNo indicates an expected call of FindAndLockByNo.
+func (mr *MockVirtualAccountRepositoryMockRecorder) FindAndLockByNo(ctx, virtualAccountNo, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAndLockByNo", reflect.TypeOf((*MockVirtualAccountRepository)(nil).FindAndLockByNo), ctx, virtualAccountNo, tx)
+}
+
+// FindByNo mocks base method.
+func (m *MockVirtualAccountRepository) FindByNo(ctx context.Context, virtualAccountNo string) (*domain.VirtualAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByNo", ctx, virtualAccountNo)
+	ret0, _ := ret[0].(*domain.VirtualAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByNo indicates an expected call of FindByNo.
+func (mr *MockVirtualAccountRepositoryMockRecorder) FindByNo(ctx, virtualAccountNo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByNo", reflect.TypeOf((*MockVirtualAccountRepository)(nil).FindByNo), ctx, virtualAccountNo)
+}
+
+// Save mocks base method.
+func (m *MockVirtualAccountRepository) Save(ctx context.Context, virtualAccount *domain.VirtualAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, virtualAccount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockVirtualAccountRepositoryMockRecorder) Save(ctx, virtualAccount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockVirtualAccountRepository)(nil).Save), ctx, virtualAccount)
+}
+
+// Update mocks base method.
+func (m *MockVirtualAccountRepository) Update(ctx context.Context, virtualAccount *domain.VirtualAccount, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, virtualAccount, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockVirtualAccountRepositoryMockRecorder) Update(ctx, virtualAccount, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockVirtualAccountRepository)(nil).Update), ctx, virtualAccount, tx)
+}
@@ -2,10 +2,13 @@ package postgresql
 
 import (
 	"context"
+	"time"
 
 	"github.com/mrth1995/go-mockva/pkg/domain"
 	"github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/metrics"
 	"github.com/mrth1995/go-mockva/pkg/repository"
+	"github.com/mrth1995/go-mockva/pkg/tenant"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -16,14 +19,14 @@ type AccountRepositoryImpl struct {
 }
 
 func NewAccountRepository(dbConnection *gorm.DB) repository.AccountRepository {
-	return &AccountRepositoryImpl{
+	return &tracedAccountRepository{next: &AccountRepositoryImpl{
 		Connection: dbConnection,
-	}
+	}}
 }
 
 func (r *AccountRepositoryImpl) FindByID(ctx context.Context, accountId string) (*domain.Account, error) {
 	var existingUser domain.Account
-	find := r.Connection.First(&existingUser, "id = ?", accountId)
+	find := r.Connection.WithContext(ctx).Scopes(forTenant(ctx)).First(&existingUser, "id = ?", accountId)
 	if find.Error != nil && find.Error == gorm.ErrRecordNotFound {
 		return nil, errors.NewAccountNotFound(accountId)
 	}
@@ -33,28 +36,76 @@ func (r *AccountRepositoryImpl) FindByID(ctx context.Context, accountId string)
 	return &existingUser, nil
 }
 
-func (r *AccountRepositoryImpl) Save(ctx context.Context, newAccount *domain.Account) error {
-	tx := r.Connection.Begin()
-	tx.Create(newAccount)
-	tx.Commit()
+// Save inserts a new account and its opening balance in the tenant of ctx, in one transaction.
+// Parameters:
+//   - ctx: The request context
+//   - newAccount: The account to insert
+//   - balance: The opening balance of the account
+//
+// Returns:
+//   - error: AccountAlreadyExist if the tenant has an account with the same ID, or the mapped database error
+func (r *AccountRepositoryImpl) Save(ctx context.Context, newAccount *domain.Account, balance *domain.AccountBalance) error {
+	newAccount.TenantID = tenant.FromContext(ctx)
+	balance.TenantID = newAccount.TenantID
+	err := r.Connection.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(newAccount).Error; err != nil {
+			return err
+		}
+		return tx.Create(balance).Error
+	})
+	if err != nil {
+		if pgErrorCode(err) == pgUniqueViolation {
+			return errors.NewAccountAlreadyExist(newAccount.ID)
+		}
+		return mapError(err)
+	}
 	return nil
 }
 
+// Update writes every field of an existing account of the tenant of ctx. Selecting the fields
+// keeps GORM from inserting the account when it does not exist.
+// Parameters:
+//   - ctx: The request context
+//   - updatedAccount: The account to write
+//
+// Returns:
+//   - *domain.Account: The updated account
+//   - error: AccountNotFound if the account does not exist, or the mapped database error
 func (r *AccountRepositoryImpl) Update(ctx context.Context, updatedAccount *domain.Account) (*domain.Account, error) {
-	tx := r.Connection.Begin()
-	tx.Save(updatedAccount)
-	tx.Commit()
+	updatedAccount.TenantID = tenant.FromContext(ctx)
+	update := r.Connection.WithContext(ctx).Select("*").Save(updatedAccount)
+	if update.Error != nil {
+		return nil, mapError(update.Error)
+	}
+	if update.RowsAffected == 0 {
+		return nil, errors.NewAccountNotFound(updatedAccount.ID)
+	}
 	return updatedAccount, nil
 }
 
-func (r *AccountRepositoryImpl) FindAndLockAccountBalance(ctx context.Context, accountID string) (*domain.AccountBalance, error) {
+// FindAndLockAccountBalance locks the balance row until tx ends, so that concurrent transfers
+// debiting or crediting the account wait for each other.
+// Parameters:
+//   - ctx: The request context
+//   - accountID: The unique account identifier
+//   - tx: The GORM transaction holding the lock
+//
+// Returns:
+//   - *domain.AccountBalance: The locked balance
+//   - error: AccountNotFound, AccountLocked if the lock timeout ends the wait, or the mapped database error
+func (r *AccountRepositoryImpl) FindAndLockAccountBalance(ctx context.Context, accountID string, tx *gorm.DB) (*domain.AccountBalance, error) {
 	var existingAccountBalance domain.AccountBalance
-	find := r.Connection.Clauses(clause.Locking{Strength: "UPDATE"}).First(&existingAccountBalance, "id = ?", accountID)
+	lockStart := time.Now()
+	find := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Scopes(forTenant(ctx)).First(&existingAccountBalance, "id = ?", accountID)
+	metrics.ObserveLockWait(time.Since(lockStart))
 	if find.Error != nil && find.Error == gorm.ErrRecordNotFound {
 		return nil, errors.NewAccountNotFound(accountID)
 	}
+	if find.Error != nil && isLockTimeout(ctx, find.Error) {
+		return nil, errors.NewAccountLocked(accountID)
+	}
 	if find.Error != nil {
-		return nil, find.Error
+		return nil, mapError(find.Error)
 	}
 	return &existingAccountBalance, nil
 }
@@ -69,8 +120,35 @@ func (r *AccountRepositoryImpl) FindAndLockAccountBalance(ctx context.Context, a
 //   - *domain.AccountBalance: The updated balance
 //   - error: If the operation fails
 func (r *AccountRepositoryImpl) UpdateBalance(ctx context.Context, accountBalance *domain.AccountBalance, tx *gorm.DB) (*domain.AccountBalance, error) {
-	if err := tx.Save(accountBalance).Error; err != nil {
-		return nil, err
+	if accountBalance.TenantID != tenant.FromContext(ctx) {
+		return nil, errors.NewAccountNotFound(accountBalance.ID)
+	}
+	if err := tx.WithContext(ctx).Save(accountBalance).Error; err != nil {
+		return nil, mapError(err)
 	}
 	return accountBalance, nil
 }
+
+// UpdateAllowNegativeBalance sets the allow_negative_balance flag of the balance of an account of the
+// tenant of ctx. The single UPDATE locks the row, so it waits for transfers posting to the balance.
+// Parameters:
+//   - ctx: The request context
+//   - accountID: The unique account identifier
+//   - allowNegativeBalance: The new value of the flag
+//
+// Returns:
+//   - error: AccountNotFound if the account has no balance, or the mapped database error
+func (r *AccountRepositoryImpl) UpdateAllowNegativeBalance(ctx context.Context, accountID string, allowNegativeBalance bool) error {
+	update := r.Connection.WithContext(ctx).Model(&domain.AccountBalance{}).Scopes(forTenant(ctx)).
+		Where("id = ?", accountID).Update("allow_negative_balance", allowNegativeBalance)
+	if update.Error != nil {
+		if isLockTimeout(ctx, update.Error) {
+			return errors.NewAccountLocked(accountID)
+		}
+		return mapError(update.Error)
+	}
+	if update.RowsAffected == 0 {
+		return errors.NewAccountNotFound(accountID)
+	}
+	return nil
+}
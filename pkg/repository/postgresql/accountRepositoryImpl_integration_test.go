@@ -0,0 +1,271 @@
+//go:build integration
+
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	"github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/migration"
+	"github.com/mrth1995/go-mockva/pkg/tenant"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// The integration tests run against the PostgreSQL database of MOCKVA_TEST_POSTGRES_DSN, e.g.
+//
+//	MOCKVA_TEST_POSTGRES_DSN="host=localhost user=postgres password=postgres dbname=mockva_test" go test -tags integration ./pkg/repository/postgresql/
+//
+// They migrate it to the latest schema and work in tenants of their own, removed afterwards.
+const testDSNEnv = "MOCKVA_TEST_POSTGRES_DSN"
+
+func openTestDB(t *testing.T, plugins ...gorm.Plugin) *gorm.DB {
+	dsn := os.Getenv(testDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDSNEnv)
+	}
+	assertions := require.New(t)
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Discard})
+	assertions.Nil(err)
+	for _, plugin := range plugins {
+		assertions.Nil(db.Use(plugin))
+	}
+	sqlDB, err := db.DB()
+	assertions.Nil(err)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	dbMigration, err := migration.NewMigration(sqlDB, "mockva")
+	assertions.Nil(err)
+	assertions.Nil(dbMigration.Up())
+	return db
+}
+
+// newTestTenant provisions a tenant for the test and returns a context operating on it.
+func newTestTenant(t *testing.T, db *gorm.DB) context.Context {
+	id := fmt.Sprintf("test-%d", time.Now().UnixNano())
+	tenantRepository := NewTenantRepository(db)
+	require.Nil(t, tenantRepository.Create(context.Background(), &domain.Tenant{ID: id, Name: t.Name()}))
+	t.Cleanup(func() {
+		ctx := context.Background()
+		_ = tenantRepository.Wipe(ctx, id)
+		db.Exec("DELETE FROM account_balances WHERE tenant_id = ?", id)
+		db.Exec("DELETE FROM accounts WHERE tenant_id = ?", id)
+		db.Exec("DELETE FROM tenants WHERE id = ?", id)
+	})
+	return tenant.WithID(context.Background(), id)
+}
+
+func seedAccount(t *testing.T, ctx context.Context, db *gorm.DB, id string) {
+	tenantID := tenant.FromContext(ctx)
+	require.Nil(t, db.Exec(`INSERT INTO accounts (tenant_id, id, account_id, name, birth_date, gender, created_at)
+		VALUES (?, ?, ?, 'Test account', '1990-01-01T00:00:00Z', true, NOW())`, tenantID, id, id).Error)
+	require.Nil(t, db.Exec(`INSERT INTO account_balances (tenant_id, id, account_id, amount, created_at)
+		VALUES (?, ?, ?, 100.00, NOW())`, tenantID, id, id).Error)
+}
+
+func TestAccountRepository_Save(t *testing.T) {
+	db := openTestDB(t)
+	ctx := newTestTenant(t, db)
+	accountRepository := NewAccountRepository(db)
+	newAccount := &domain.Account{ID: "001", AccountID: "001", Name: "Siska", BirthDate: time.Date(1996, 3, 11, 0, 0, 0, 0, time.UTC)}
+
+	assertions := require.New(t)
+	assertions.Nil(accountRepository.Save(ctx, newAccount, &domain.AccountBalance{ID: "001", AccountID: "001"}))
+	saved, err := accountRepository.FindByID(ctx, "001")
+	assertions.Nil(err)
+	assertions.Equal("Siska", saved.Name)
+	assertions.False(saved.CreatedAt.IsZero())
+	var balance domain.AccountBalance
+	assertions.Nil(db.First(&balance, "tenant_id = ? AND id = ?", tenant.FromContext(ctx), "001").Error)
+	assertions.Equal(0.0, balance.Balance)
+	assertions.False(balance.AllowNegativeBalance)
+
+	err = accountRepository.Save(ctx, &domain.Account{ID: "001", AccountID: "001", Name: "Ridwan", BirthDate: time.Now()}, &domain.AccountBalance{ID: "001", AccountID: "001"})
+	assertions.ErrorIs(err, errors.ErrAccountAlreadyExist)
+}
+
+func TestAccountRepository_UpdateBalance(t *testing.T) {
+	db := openTestDB(t)
+	ctx := newTestTenant(t, db)
+	seedAccount(t, ctx, db, "001")
+	accountRepository := NewAccountRepository(db)
+
+	assertions := require.New(t)
+	err := NewGormTransactionManager(db).Transaction(ctx, func(tx *gorm.DB) error {
+		balance, err := accountRepository.FindAndLockAccountBalance(ctx, "001", tx)
+		if err != nil {
+			return err
+		}
+		balance.Balance -= 150
+		balance.AllowNegativeBalance = true
+		_, err = accountRepository.UpdateBalance(ctx, balance, tx)
+		return err
+	})
+	assertions.Nil(err)
+
+	var balance domain.AccountBalance
+	assertions.Nil(db.First(&balance, "tenant_id = ? AND id = ?", tenant.FromContext(ctx), "001").Error)
+	assertions.Equal(-50.0, balance.Balance)
+	assertions.True(balance.AllowNegativeBalance)
+}
+
+func TestAccountRepository_UpdateAllowNegativeBalance(t *testing.T) {
+	db := openTestDB(t)
+	ctx := newTestTenant(t, db)
+	seedAccount(t, ctx, db, "001")
+	accountRepository := NewAccountRepository(db)
+
+	assertions := require.New(t)
+	assertions.Nil(accountRepository.UpdateAllowNegativeBalance(ctx, "001", true))
+	var balance domain.AccountBalance
+	assertions.Nil(db.First(&balance, "tenant_id = ? AND id = ?", tenant.FromContext(ctx), "001").Error)
+	assertions.True(balance.AllowNegativeBalance)
+	assertions.Equal(100.0, balance.Balance)
+
+	assertions.ErrorIs(accountRepository.UpdateAllowNegativeBalance(ctx, "404", true), errors.ErrAccountNotFound)
+}
+
+func TestAccountRepository_Update_DuplicateAccountID(t *testing.T) {
+	db := openTestDB(t)
+	ctx := newTestTenant(t, db)
+	seedAccount(t, ctx, db, "001")
+	seedAccount(t, ctx, db, "002")
+
+	accountRepository := NewAccountRepository(db)
+	account, err := accountRepository.FindByID(ctx, "001")
+	assertions := require.New(t)
+	assertions.Nil(err)
+	account.AccountID = "002"
+
+	_, err = accountRepository.Update(ctx, account)
+	assertions.ErrorIs(err, errors.ErrConflict)
+}
+
+func TestAccountRepository_Update_ReferencedAccountID(t *testing.T) {
+	db := openTestDB(t)
+	ctx := newTestTenant(t, db)
+	seedAccount(t, ctx, db, "001")
+
+	accountRepository := NewAccountRepository(db)
+	account, err := accountRepository.FindByID(ctx, "001")
+	assertions := require.New(t)
+	assertions.Nil(err)
+	// The balance of the account still references its account ID
+	account.AccountID = "003"
+
+	_, err = accountRepository.Update(ctx, account)
+	assertions.ErrorIs(err, errors.ErrConflict)
+}
+
+func TestAccountRepository_Update_NotFound(t *testing.T) {
+	db := openTestDB(t)
+	ctx := newTestTenant(t, db)
+
+	_, err := NewAccountRepository(db).Update(ctx, &domain.Account{ID: "404", AccountID: "404", Name: "Missing", BirthDate: time.Now()})
+	require.ErrorIs(t, err, errors.ErrAccountNotFound)
+}
+
+func TestAccountRepository_FindAndLockAccountBalance_LockTimeout(t *testing.T) {
+	testCases := []struct {
+		name     string
+		plugins  []gorm.Plugin
+		setLocal string
+	}{
+		{name: "PostgreSQL lock timeout", setLocal: "SET LOCAL lock_timeout = '100ms'"},
+		{name: "Timeouts plugin", plugins: []gorm.Plugin{&Timeouts{Lock: 100 * time.Millisecond}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := openTestDB(t, tc.plugins...)
+			ctx := newTestTenant(t, db)
+			seedAccount(t, ctx, db, "001")
+			accountRepository := NewAccountRepository(db)
+			assertions := require.New(t)
+
+			holder := db.WithContext(ctx).Begin()
+			assertions.Nil(holder.Error)
+			defer holder.Rollback()
+			_, err := accountRepository.FindAndLockAccountBalance(ctx, "001", holder)
+			assertions.Nil(err)
+
+			err = NewGormTransactionManager(db).Transaction(ctx, func(tx *gorm.DB) error {
+				if tc.setLocal != "" {
+					if err := tx.Exec(tc.setLocal).Error; err != nil {
+						return err
+					}
+				}
+				_, err := accountRepository.FindAndLockAccountBalance(ctx, "001", tx)
+				return err
+			})
+			assertions.ErrorIs(err, errors.ErrAccountLocked)
+		})
+	}
+}
+
+func TestGormTransactionManager_SerializationFailure(t *testing.T) {
+	db := openTestDB(t)
+	ctx := newTestTenant(t, db)
+	seedAccount(t, ctx, db, "001")
+	tenantID := tenant.FromContext(ctx)
+	const addOne = "UPDATE account_balances SET amount = amount + 1 WHERE tenant_id = ? AND id = ?"
+
+	err := NewGormTransactionManager(db).Transaction(ctx, func(tx *gorm.DB) error {
+		if err := tx.Exec("SET TRANSACTION ISOLATION LEVEL REPEATABLE READ").Error; err != nil {
+			return err
+		}
+		var amount float64
+		if err := tx.Raw("SELECT amount FROM account_balances WHERE tenant_id = ? AND id = ?", tenantID, "001").Scan(&amount).Error; err != nil {
+			return err
+		}
+		// Updated by another transaction after the snapshot of tx was taken
+		if err := db.Exec(addOne, tenantID, "001").Error; err != nil {
+			return err
+		}
+		return tx.Exec(addOne, tenantID, "001").Error
+	})
+	require.ErrorIs(t, err, errors.ErrConflict)
+}
+
+func TestGormTransactionManager_Deadlock(t *testing.T) {
+	db := openTestDB(t)
+	ctx := newTestTenant(t, db)
+	seedAccount(t, ctx, db, "001")
+	seedAccount(t, ctx, db, "002")
+	accountRepository := NewAccountRepository(db)
+	transactionManager := NewGormTransactionManager(db)
+
+	// Each transaction locks one balance, waits until the other locked the other one, then locks it.
+	locked := make(chan struct{}, 2)
+	lockInOrder := func(first, second string) error {
+		return transactionManager.Transaction(ctx, func(tx *gorm.DB) error {
+			if _, err := accountRepository.FindAndLockAccountBalance(ctx, first, tx); err != nil {
+				return err
+			}
+			locked <- struct{}{}
+			for len(locked) < 2 {
+				time.Sleep(10 * time.Millisecond)
+			}
+			_, err := accountRepository.FindAndLockAccountBalance(ctx, second, tx)
+			return err
+		})
+	}
+	results := make(chan error, 2)
+	go func() { results <- lockInOrder("001", "002") }()
+	go func() { results <- lockInOrder("002", "001") }()
+
+	// PostgreSQL aborts one of them and the other one commits
+	first, second := <-results, <-results
+	assertions := require.New(t)
+	if first == nil {
+		first, second = second, first
+	}
+	assertions.ErrorIs(first, errors.ErrConflict)
+	assertions.Nil(second)
+}
@@ -0,0 +1,64 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	"github.com/mrth1995/go-mockva/pkg/repository"
+	"github.com/mrth1995/go-mockva/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// tracedAccountRepository wraps every call of an AccountRepository in a span, the parent of the
+// spans of the queries it runs.
+type tracedAccountRepository struct {
+	next repository.AccountRepository
+}
+
+func (r *tracedAccountRepository) FindByID(ctx context.Context, accountId string) (*domain.Account, error) {
+	ctx, span := startAccountSpan(ctx, "AccountRepository.FindByID", accountId)
+	account, err := r.next.FindByID(ctx, accountId)
+	tracing.End(span, err)
+	return account, err
+}
+
+func (r *tracedAccountRepository) Save(ctx context.Context, newAccount *domain.Account, balance *domain.AccountBalance) error {
+	ctx, span := startAccountSpan(ctx, "AccountRepository.Save", newAccount.ID)
+	err := r.next.Save(ctx, newAccount, balance)
+	tracing.End(span, err)
+	return err
+}
+
+func (r *tracedAccountRepository) Update(ctx context.Context, updatedAccount *domain.Account) (*domain.Account, error) {
+	ctx, span := startAccountSpan(ctx, "AccountRepository.Update", updatedAccount.ID)
+	account, err := r.next.Update(ctx, updatedAccount)
+	tracing.End(span, err)
+	return account, err
+}
+
+func (r *tracedAccountRepository) FindAndLockAccountBalance(ctx context.Context, accountID string, tx *gorm.DB) (*domain.AccountBalance, error) {
+	ctx, span := startAccountSpan(ctx, "AccountRepository.FindAndLockAccountBalance", accountID)
+	accountBalance, err := r.next.FindAndLockAccountBalance(ctx, accountID, tx)
+	tracing.End(span, err)
+	return accountBalance, err
+}
+
+func (r *tracedAccountRepository) UpdateBalance(ctx context.Context, accountBalance *domain.AccountBalance, tx *gorm.DB) (*domain.AccountBalance, error) {
+	ctx, span := startAccountSpan(ctx, "AccountRepository.UpdateBalance", accountBalance.ID)
+	updated, err := r.next.UpdateBalance(ctx, accountBalance, tx)
+	tracing.End(span, err)
+	return updated, err
+}
+
+func (r *tracedAccountRepository) UpdateAllowNegativeBalance(ctx context.Context, accountID string, allowNegativeBalance bool) error {
+	ctx, span := startAccountSpan(ctx, "AccountRepository.UpdateAllowNegativeBalance", accountID)
+	err := r.next.UpdateAllowNegativeBalance(ctx, accountID, allowNegativeBalance)
+	tracing.End(span, err)
+	return err
+}
+
+func startAccountSpan(ctx context.Context, name, accountID string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, name, trace.WithAttributes(attribute.String("mockva.account_id", accountID)))
+}
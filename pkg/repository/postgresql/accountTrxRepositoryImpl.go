@@ -1,9 +1,15 @@
 package postgresql
 
 import (
+	"context"
+	"encoding/json"
+	"time"
+
 	"github.com/mrth1995/go-mockva/pkg/domain"
+	"github.com/mrth1995/go-mockva/pkg/errors"
 	"github.com/mrth1995/go-mockva/pkg/repository"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type AccountTrxRepositoryImpl struct {
@@ -18,14 +24,125 @@ func NewAccountTrxRepository(dbConnection *gorm.DB) repository.AccountTransactio
 
 // Save persists an AccountTransaction within the provided transaction context.
 // Parameters:
+//   - ctx: The request context
 //   - trx: The AccountTransaction to save
 //   - tx: The GORM transaction context
 //
 // Returns:
 //   - error: If the operation fails
-func (r *AccountTrxRepositoryImpl) Save(trx *domain.AccountTransaction, tx *gorm.DB) error {
-	if err := tx.Create(trx).Error; err != nil {
-		return err
+func (r *AccountTrxRepositoryImpl) Save(ctx context.Context, trx *domain.AccountTransaction, tx *gorm.DB) error {
+	if trx.TenantID == "" {
+		return errors.NewValidationError("transaction " + trx.ID + " has no tenant")
+	}
+	if err := tx.WithContext(ctx).Create(trx).Error; err != nil {
+		return mapError(err)
 	}
 	return nil
 }
+
+// Update persists the status changes of an existing AccountTransaction within the provided transaction context.
+// Parameters:
+//   - ctx: The request context
+//   - trx: The AccountTransaction to update
+//   - tx: The GORM transaction context
+//
+// Returns:
+//   - error: If the operation fails
+func (r *AccountTrxRepositoryImpl) Update(ctx context.Context, trx *domain.AccountTransaction, tx *gorm.DB) error {
+	err := tx.WithContext(ctx).Model(trx).
+		Scopes(forTenantID(trx.TenantID)).
+		Omit(clause.Associations).
+		Select("Status", "StatusReason", "SettledAt").
+		Updates(trx).Error
+	return mapError(err)
+}
+
+func (r *AccountTrxRepositoryImpl) FindByID(ctx context.Context, id string) (*domain.AccountTransaction, error) {
+	var trx domain.AccountTransaction
+	find := r.Connection.WithContext(ctx).Scopes(forTenant(ctx)).First(&trx, "id = ?", id)
+	if find.Error != nil && find.Error == gorm.ErrRecordNotFound {
+		return nil, errors.NewTransactionNotFound(id)
+	}
+	if find.Error != nil {
+		return nil, find.Error
+	}
+	return &trx, nil
+}
+
+func (r *AccountTrxRepositoryImpl) FindAndLockByID(ctx context.Context, id string, tx *gorm.DB) (*domain.AccountTransaction, error) {
+	var trx domain.AccountTransaction
+	find := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Scopes(forTenant(ctx)).First(&trx, "id = ?", id)
+	if find.Error != nil && find.Error == gorm.ErrRecordNotFound {
+		return nil, errors.NewTransactionNotFound(id)
+	}
+	if find.Error != nil {
+		return nil, find.Error
+	}
+	return &trx, nil
+}
+
+func (r *AccountTrxRepositoryImpl) FindPendingBefore(ctx context.Context, before time.Time, limit int) ([]domain.AccountTransaction, error) {
+	var trxs []domain.AccountTransaction
+	err := r.Connection.WithContext(ctx).
+		Where("status = ? AND transaction_timestamp <= ?", domain.TransactionStatusPending, before).
+		Order("transaction_timestamp").
+		Limit(limit).
+		Find(&trxs).Error
+	if err != nil {
+		return nil, err
+	}
+	return trxs, nil
+}
+
+func (r *AccountTrxRepositoryImpl) Search(ctx context.Context, filter *repository.AccountTransactionFilter) ([]domain.AccountTransaction, int64, error) {
+	query := r.Connection.WithContext(ctx).Model(&domain.AccountTransaction{}).Scopes(forTenant(ctx))
+	if filter.AccountID != "" {
+		query = query.Where("(account_src_id = ? OR account_dst_id = ?)", filter.AccountID, filter.AccountID)
+	}
+	if filter.ExternalReference != "" {
+		query = query.Where("external_reference = ?", filter.ExternalReference)
+	}
+	if filter.PurposeCode != "" {
+		query = query.Where("purpose_code = ?", filter.PurposeCode)
+	}
+	if filter.Remark != "" {
+		query = query.Where("remark ILIKE ?", "%"+filter.Remark+"%")
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Type != "" {
+		query = query.Where("transaction_type = ?", filter.Type)
+	}
+	if filter.ClientID != "" {
+		query = query.Where("client_id = ?", filter.ClientID)
+	}
+	if len(filter.Metadata) > 0 {
+		metadata, err := json.Marshal(filter.Metadata)
+		if err != nil {
+			return nil, 0, err
+		}
+		query = query.Where("metadata @> ?::jsonb", string(metadata))
+	}
+	if filter.From != nil {
+		query = query.Where("transaction_timestamp >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("transaction_timestamp < ?", *filter.To)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var trxs []domain.AccountTransaction
+	err := query.
+		Order("transaction_timestamp DESC").
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Find(&trxs).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return trxs, total, nil
+}
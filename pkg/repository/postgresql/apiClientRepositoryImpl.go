@@ -0,0 +1,44 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	"github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/repository"
+	"gorm.io/gorm"
+)
+
+type APIClientRepositoryImpl struct {
+	Connection *gorm.DB
+}
+
+func NewAPIClientRepository(dbConnection *gorm.DB) repository.APIClientRepository {
+	return &APIClientRepositoryImpl{
+		Connection: dbConnection,
+	}
+}
+
+func (r *APIClientRepositoryImpl) FindByID(ctx context.Context, id string) (*domain.APIClient, error) {
+	var client domain.APIClient
+	find := r.Connection.WithContext(ctx).First(&client, "id = ?", id)
+	if find.Error != nil && find.Error == gorm.ErrRecordNotFound {
+		return nil, errors.NewAPIClientNotFound(id)
+	}
+	if find.Error != nil {
+		return nil, find.Error
+	}
+	return &client, nil
+}
+
+func (r *APIClientRepositoryImpl) FindAll(ctx context.Context) ([]domain.APIClient, error) {
+	var clients []domain.APIClient
+	if err := r.Connection.WithContext(ctx).Order("id").Find(&clients).Error; err != nil {
+		return nil, err
+	}
+	return clients, nil
+}
+
+func (r *APIClientRepositoryImpl) Save(ctx context.Context, client *domain.APIClient) error {
+	return r.Connection.WithContext(ctx).Save(client).Error
+}
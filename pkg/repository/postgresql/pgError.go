@@ -0,0 +1,63 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/mrth1995/go-mockva/pkg/errors"
+)
+
+// PostgreSQL error codes mapped to domain errors, see https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	pgUniqueViolation      = "23505"
+	pgForeignKeyViolation  = "23503"
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+	pgLockNotAvailable     = "55P03"
+	pgQueryCanceled        = "57014"
+)
+
+// pgErrorCode returns the SQLSTATE of the PostgreSQL error in err's chain, or an empty string
+// when err did not come from PostgreSQL.
+func pgErrorCode(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code
+	}
+	return ""
+}
+
+// mapError converts the PostgreSQL errors a client can act on into domain errors. Constraint
+// violations, serialization failures and deadlocks become conflicts, the last two asking the client
+// to retry, and lock and statement timeouts become timeouts. Other errors are returned unchanged.
+func mapError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+	switch pgErr.Code {
+	case pgUniqueViolation:
+		return errors.NewConflict("duplicate key violates constraint " + pgErr.ConstraintName)
+	case pgForeignKeyViolation:
+		return errors.NewConflict("reference violates constraint " + pgErr.ConstraintName)
+	case pgSerializationFailure, pgDeadlockDetected:
+		return errors.NewConflict("concurrent update, retry the request")
+	case pgLockNotAvailable:
+		return errors.NewTimeout("timed out waiting for a lock")
+	case pgQueryCanceled:
+		return errors.NewTimeout("statement timed out")
+	}
+	return err
+}
+
+// isLockTimeout reports whether err ended a statement waiting for a row lock: PostgreSQL gave up
+// after its lock timeout, or the Timeouts plugin cancelled the statement while ctx was still alive.
+func isLockTimeout(ctx context.Context, err error) bool {
+	if pgErrorCode(err) == pgLockNotAvailable {
+		return true
+	}
+	return errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil
+}
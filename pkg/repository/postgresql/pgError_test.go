@@ -0,0 +1,51 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected error
+	}{
+		{name: "Unique violation", err: &pgconn.PgError{Code: pgUniqueViolation, ConstraintName: "account_id_unique"}, expected: errors.ErrConflict},
+		{name: "Foreign key violation", err: &pgconn.PgError{Code: pgForeignKeyViolation}, expected: errors.ErrConflict},
+		{name: "Serialization failure", err: &pgconn.PgError{Code: pgSerializationFailure}, expected: errors.ErrConflict},
+		{name: "Deadlock", err: &pgconn.PgError{Code: pgDeadlockDetected}, expected: errors.ErrConflict},
+		{name: "Lock timeout", err: &pgconn.PgError{Code: pgLockNotAvailable}, expected: errors.ErrTimeout},
+		{name: "Statement timeout", err: &pgconn.PgError{Code: pgQueryCanceled}, expected: errors.ErrTimeout},
+		{name: "Wrapped", err: fmt.Errorf("commit: %w", &pgconn.PgError{Code: pgSerializationFailure}), expected: errors.ErrConflict},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.ErrorIs(t, mapError(tc.err), tc.expected)
+		})
+	}
+}
+
+func TestMapError_Unmapped(t *testing.T) {
+	assertions := require.New(t)
+	syntaxErr := &pgconn.PgError{Code: "42601"}
+	assertions.Same(syntaxErr, mapError(syntaxErr))
+	assertions.Equal(context.Canceled, mapError(context.Canceled))
+	assertions.Nil(mapError(nil))
+}
+
+func TestIsLockTimeout(t *testing.T) {
+	expired, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assertions := require.New(t)
+	assertions.True(isLockTimeout(context.Background(), &pgconn.PgError{Code: pgLockNotAvailable}))
+	assertions.True(isLockTimeout(context.Background(), fmt.Errorf("query: %w", context.DeadlineExceeded)))
+	assertions.False(isLockTimeout(expired, fmt.Errorf("query: %w", context.DeadlineExceeded)))
+	assertions.False(isLockTimeout(context.Background(), &pgconn.PgError{Code: pgQueryCanceled}))
+}
@@ -0,0 +1,136 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// entities are the models the repositories read and write with GORM.
+var entities = []any{
+	&domain.Tenant{},
+	&domain.Account{},
+	&domain.AccountBalance{},
+	&domain.AccountTransaction{},
+	&domain.VirtualAccount{},
+	&domain.APIClient{},
+}
+
+// columnKinds groups the PostgreSQL column types by the Go values they hold.
+var columnKinds = map[string]string{
+	"varchar":     "string",
+	"bpchar":      "string",
+	"text":        "string",
+	"numeric":     "number",
+	"float4":      "number",
+	"float8":      "number",
+	"int2":        "integer",
+	"int4":        "integer",
+	"int8":        "integer",
+	"bool":        "bool",
+	"date":        "time",
+	"timestamp":   "time",
+	"timestamptz": "time",
+	"json":        "json",
+	"jsonb":       "json",
+}
+
+// VerifySchema compares the entities with the tables of the database, so that a mapping that does
+// not match the migrations fails at startup rather than on the first request writing it. The error
+// lists every difference found: entities GORM cannot map, missing tables and columns, columns whose
+// type cannot hold their field, and NOT NULL columns without a default that inserts leave empty.
+func VerifySchema(ctx context.Context, db *gorm.DB) error {
+	db = db.WithContext(ctx)
+	var diff []string
+	for _, entity := range entities {
+		statement := &gorm.Statement{DB: db}
+		if err := statement.Parse(entity); err != nil {
+			diff = append(diff, fmt.Sprintf("%s: %v", reflect.TypeOf(entity).Elem().Name(), err))
+			continue
+		}
+		table := statement.Schema.Table
+		if !db.Migrator().HasTable(table) {
+			diff = append(diff, fmt.Sprintf("%s: table of %s is missing", table, statement.Schema.Name))
+			continue
+		}
+		columns, err := db.Migrator().ColumnTypes(table)
+		if err != nil {
+			return fmt.Errorf("unable to read the columns of %s: %w", table, err)
+		}
+		diff = append(diff, diffTable(statement.Schema, columns)...)
+	}
+	if len(diff) > 0 {
+		return fmt.Errorf("database schema does not match the entities:\n  %s", strings.Join(diff, "\n  "))
+	}
+	return nil
+}
+
+// diffTable returns the differences between the fields of entity and the columns of its table.
+func diffTable(entity *schema.Schema, columns []gorm.ColumnType) []string {
+	columnsByName := make(map[string]gorm.ColumnType, len(columns))
+	for _, column := range columns {
+		columnsByName[column.Name()] = column
+	}
+
+	var diff []string
+	written := make(map[string]bool)
+	for _, field := range entity.Fields {
+		if field.DBName == "" {
+			continue
+		}
+		written[field.DBName] = written[field.DBName] || field.Creatable
+		column, ok := columnsByName[field.DBName]
+		if !ok {
+			diff = append(diff, fmt.Sprintf("%s.%s: column of %s.%s is missing", entity.Table, field.DBName, entity.Name, field.Name))
+			continue
+		}
+		if !holds(column, field) {
+			diff = append(diff, fmt.Sprintf("%s.%s: %s column cannot hold %s.%s of type %s",
+				entity.Table, field.DBName, column.DatabaseTypeName(), entity.Name, field.Name, field.FieldType))
+		}
+	}
+	for _, column := range columns {
+		if written[column.Name()] {
+			continue
+		}
+		nullable, _ := column.Nullable()
+		_, hasDefault := column.DefaultValue()
+		autoIncrement, _ := column.AutoIncrement()
+		if !nullable && !hasDefault && !autoIncrement {
+			diff = append(diff, fmt.Sprintf("%s.%s: NOT NULL column without default is not written by %s", entity.Table, column.Name(), entity.Name))
+		}
+	}
+	return diff
+}
+
+// holds reports whether column can store the values of field. Fields of types it does not know,
+// e.g. custom Valuers, are assumed to fit.
+func holds(column gorm.ColumnType, field *schema.Field) bool {
+	columnKind, known := columnKinds[column.DatabaseTypeName()]
+	if !known {
+		return true
+	}
+	var fieldKind string
+	switch {
+	case field.Serializer != nil:
+		fieldKind = "json"
+	case field.DataType == schema.String:
+		fieldKind = "string"
+	case field.DataType == schema.Float:
+		fieldKind = "number"
+	case field.DataType == schema.Int, field.DataType == schema.Uint:
+		fieldKind = "integer"
+	case field.DataType == schema.Bool:
+		fieldKind = "bool"
+	case field.DataType == schema.Time:
+		fieldKind = "time"
+	default:
+		return true
+	}
+	return fieldKind == columnKind || (fieldKind == "integer" && columnKind == "number")
+}
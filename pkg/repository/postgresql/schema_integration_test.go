@@ -0,0 +1,15 @@
+//go:build integration
+
+package postgresql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySchema(t *testing.T) {
+	db := openTestDB(t)
+	require.Nil(t, VerifySchema(context.Background(), db))
+}
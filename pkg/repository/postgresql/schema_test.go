@@ -0,0 +1,96 @@
+package postgresql
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/migrator"
+	"gorm.io/gorm/schema"
+)
+
+func column(name, dataType string, nullable bool, defaultValue string) gorm.ColumnType {
+	return migrator.ColumnType{
+		NameValue:         sql.NullString{String: name, Valid: true},
+		DataTypeValue:     sql.NullString{String: dataType, Valid: true},
+		NullableValue:     sql.NullBool{Bool: nullable, Valid: true},
+		DefaultValueValue: sql.NullString{String: defaultValue, Valid: defaultValue != ""},
+	}
+}
+
+func parseEntity(t *testing.T, entity any) *schema.Schema {
+	db, err := gorm.Open(postgres.Open("host=localhost"), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	require.Nil(t, err)
+	statement := &gorm.Statement{DB: db}
+	require.Nil(t, statement.Parse(entity))
+	return statement.Schema
+}
+
+func TestEntitiesParse(t *testing.T) {
+	for _, entity := range entities {
+		parseEntity(t, entity)
+	}
+}
+
+func TestDiffTable(t *testing.T) {
+	// account_balances as created by the migrations
+	balanceColumns := []gorm.ColumnType{
+		column("id", "varchar", false, ""),
+		column("account_id", "varchar", false, ""),
+		column("amount", "numeric", false, "0.00"),
+		column("created_at", "timestamptz", false, ""),
+		column("updated_at", "timestamptz", true, ""),
+		column("tenant_id", "varchar", false, ""),
+		column("allow_negative_balance", "bool", false, "false"),
+	}
+	testCases := []struct {
+		name         string
+		columns      []gorm.ColumnType
+		expectedDiff []string
+	}{
+		{name: "Matching", columns: balanceColumns},
+		{
+			name:         "Missing column",
+			columns:      balanceColumns[:6],
+			expectedDiff: []string{"account_balances.allow_negative_balance: column of AccountBalance.AllowNegativeBalance is missing"},
+		},
+		{
+			name:         "Incompatible type",
+			columns:      append([]gorm.ColumnType{column("amount", "varchar", false, "")}, balanceColumns[3:]...),
+			expectedDiff: []string{"account_balances.id: column of AccountBalance.ID is missing", "account_balances.account_id: column of AccountBalance.AccountID is missing", "account_balances.amount: varchar column cannot hold AccountBalance.Balance of type float64"},
+		},
+		{
+			name:         "Not null column not written",
+			columns:      append(balanceColumns, column("region", "varchar", false, ""), column("branch", "varchar", false, "'HQ'")),
+			expectedDiff: []string{"account_balances.region: NOT NULL column without default is not written by AccountBalance"},
+		},
+	}
+	accountBalance := parseEntity(t, &domain.AccountBalance{})
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectedDiff, diffTable(accountBalance, tc.columns))
+		})
+	}
+}
+
+func TestDiffTable_JSONField(t *testing.T) {
+	apiClient := parseEntity(t, &domain.APIClient{})
+	columns := []gorm.ColumnType{
+		column("id", "varchar", false, ""),
+		column("name", "varchar", false, ""),
+		column("tenant_id", "varchar", true, ""),
+		column("secret_hash", "varchar", true, ""),
+		column("public_key", "text", true, ""),
+		column("client_secret", "varchar", true, ""),
+		column("scopes", "jsonb", true, ""),
+		column("allowed_ips", "varchar", true, ""),
+		column("enabled", "bool", false, "true"),
+		column("rate_limit", "int4", false, "0"),
+		column("created_at", "timestamptz", false, ""),
+		column("updated_at", "timestamptz", true, ""),
+	}
+	require.Equal(t, []string{"api_clients.allowed_ips: varchar column cannot hold APIClient.AllowedIPs of type []string"}, diffTable(apiClient, columns))
+}
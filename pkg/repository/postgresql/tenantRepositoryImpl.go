@@ -0,0 +1,82 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	"github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/repository"
+	"gorm.io/gorm"
+)
+
+type TenantRepositoryImpl struct {
+	Connection *gorm.DB
+}
+
+func NewTenantRepository(dbConnection *gorm.DB) repository.TenantRepository {
+	return &TenantRepositoryImpl{
+		Connection: dbConnection,
+	}
+}
+
+var settlementAccountIDs = []string{domain.CashInSettlementAccountID, domain.CashOutSettlementAccountID}
+
+func (r *TenantRepositoryImpl) FindByID(ctx context.Context, id string) (*domain.Tenant, error) {
+	var existingTenant domain.Tenant
+	find := r.Connection.WithContext(ctx).First(&existingTenant, "id = ?", id)
+	if find.Error != nil && find.Error == gorm.ErrRecordNotFound {
+		return nil, errors.NewTenantNotFound(id)
+	}
+	if find.Error != nil {
+		return nil, find.Error
+	}
+	return &existingTenant, nil
+}
+
+func (r *TenantRepositoryImpl) FindAll(ctx context.Context) ([]domain.Tenant, error) {
+	var tenants []domain.Tenant
+	if err := r.Connection.WithContext(ctx).Order("id").Find(&tenants).Error; err != nil {
+		return nil, err
+	}
+	return tenants, nil
+}
+
+func (r *TenantRepositoryImpl) Create(ctx context.Context, newTenant *domain.Tenant) error {
+	return r.Connection.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(newTenant).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec(`INSERT INTO accounts (tenant_id, id, account_id, name, birth_date, gender, created_at)
+			VALUES (?, ?, ?, 'Cash-in settlement', '1970-01-01T00:00:00Z', true, NOW()),
+			       (?, ?, ?, 'Cash-out settlement', '1970-01-01T00:00:00Z', true, NOW())`,
+			newTenant.ID, domain.CashInSettlementAccountID, domain.CashInSettlementAccountID,
+			newTenant.ID, domain.CashOutSettlementAccountID, domain.CashOutSettlementAccountID).Error; err != nil {
+			return err
+		}
+		return tx.Exec(`INSERT INTO account_balances (tenant_id, id, account_id, amount, allow_negative_balance, created_at)
+			VALUES (?, ?, ?, 0.00, TRUE, NOW()), (?, ?, ?, 0.00, TRUE, NOW())`,
+			newTenant.ID, domain.CashInSettlementAccountID, domain.CashInSettlementAccountID,
+			newTenant.ID, domain.CashOutSettlementAccountID, domain.CashOutSettlementAccountID).Error
+	})
+}
+
+func (r *TenantRepositoryImpl) Wipe(ctx context.Context, id string) error {
+	return r.Connection.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		statements := []struct {
+			query string
+			args  []any
+		}{
+			{"DELETE FROM virtual_accounts WHERE tenant_id = ?", []any{id}},
+			{"DELETE FROM account_transactions WHERE tenant_id = ?", []any{id}},
+			{"DELETE FROM account_balances WHERE tenant_id = ? AND account_id NOT IN ?", []any{id, settlementAccountIDs}},
+			{"UPDATE account_balances SET amount = 0.00, updated_at = NOW() WHERE tenant_id = ?", []any{id}},
+			{"DELETE FROM accounts WHERE tenant_id = ? AND account_id NOT IN ?", []any{id, settlementAccountIDs}},
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement.query, statement.args...).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
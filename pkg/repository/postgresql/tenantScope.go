@@ -0,0 +1,21 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/mrth1995/go-mockva/pkg/tenant"
+	"gorm.io/gorm"
+)
+
+// forTenant restricts a query to the rows of the tenant ctx operates on. Every query on tenant
+// owned tables goes through it, so a request never sees the rows of another tenant.
+func forTenant(ctx context.Context) func(db *gorm.DB) *gorm.DB {
+	return forTenantID(tenant.FromContext(ctx))
+}
+
+// forTenantID restricts a query to the rows of the tenant tenantID.
+func forTenantID(tenantID string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("tenant_id = ?", tenantID)
+	}
+}
@@ -0,0 +1,76 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	timeoutsInstanceKey = "mockva:timeouts"
+	timeoutStart        = "mockva:timeout_start"
+	timeoutStop         = "mockva:timeout_stop"
+)
+
+// Timeouts bounds the context of every statement by the statement timeout, or the lock timeout for
+// the statements locking rows with FOR UPDATE, so that a query waiting on a lock held by a stuck
+// transaction is cancelled even when PostgreSQL does not enforce the session timeouts, e.g. behind
+// a connection pooler. The request context still cancels statements sooner when it is done.
+type Timeouts struct {
+	Statement time.Duration
+	Lock      time.Duration
+}
+
+type statementContext struct {
+	parent context.Context
+	cancel context.CancelFunc
+}
+
+func (t *Timeouts) Name() string {
+	return "mockva:timeouts"
+}
+
+// Initialize registers the callbacks starting the timeout before the statement, and its implicit
+// transaction, and stopping it afterwards. Rows are read after the row callbacks return, so
+// Row and Rows statements are only bounded by their context.
+func (t *Timeouts) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+	return errors.Join(
+		callback.Create().Before("gorm:begin_transaction").Register(timeoutStart, t.start),
+		callback.Create().After("gorm:commit_or_rollback_transaction").Register(timeoutStop, t.stop),
+		callback.Query().Before("gorm:query").Register(timeoutStart, t.start),
+		callback.Query().After("gorm:query").Register(timeoutStop, t.stop),
+		callback.Update().Before("gorm:begin_transaction").Register(timeoutStart, t.start),
+		callback.Update().After("gorm:commit_or_rollback_transaction").Register(timeoutStop, t.stop),
+		callback.Delete().Before("gorm:begin_transaction").Register(timeoutStart, t.start),
+		callback.Delete().After("gorm:commit_or_rollback_transaction").Register(timeoutStop, t.stop),
+		callback.Raw().Before("gorm:raw").Register(timeoutStart, t.start),
+		callback.Raw().After("gorm:raw").Register(timeoutStop, t.stop),
+	)
+}
+
+func (t *Timeouts) start(db *gorm.DB) {
+	timeout := t.Statement
+	if _, locking := db.Statement.Clauses["FOR"]; locking && t.Lock > 0 && (timeout == 0 || t.Lock < timeout) {
+		timeout = t.Lock
+	}
+	if timeout <= 0 {
+		return
+	}
+	parent := db.Statement.Context
+	ctx, cancel := context.WithTimeout(parent, timeout)
+	db.Statement.Context = ctx
+	db.InstanceSet(timeoutsInstanceKey, statementContext{parent: parent, cancel: cancel})
+}
+
+func (t *Timeouts) stop(db *gorm.DB) {
+	value, ok := db.InstanceGet(timeoutsInstanceKey)
+	if !ok {
+		return
+	}
+	statement := value.(statementContext)
+	statement.cancel()
+	db.Statement.Context = statement.parent
+}
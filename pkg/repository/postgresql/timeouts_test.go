@@ -0,0 +1,66 @@
+package postgresql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func TestTimeouts(t *testing.T) {
+	testCases := []struct {
+		name             string
+		timeouts         Timeouts
+		locking          bool
+		requestTimeout   time.Duration
+		expectedDeadline time.Duration
+	}{
+		{name: "Statement timeout", timeouts: Timeouts{Statement: time.Minute, Lock: time.Second}, expectedDeadline: time.Minute},
+		{name: "Lock timeout", timeouts: Timeouts{Statement: time.Minute, Lock: time.Second}, locking: true, expectedDeadline: time.Second},
+		{name: "Lock timeout longer than statement timeout", timeouts: Timeouts{Statement: time.Second, Lock: time.Minute}, locking: true, expectedDeadline: time.Second},
+		{name: "Request deadline first", timeouts: Timeouts{Statement: time.Minute}, requestTimeout: time.Second, expectedDeadline: time.Second},
+		{name: "No timeout", timeouts: Timeouts{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assertions := require.New(t)
+			db, err := gorm.Open(postgres.Open("host=localhost"), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+			assertions.Nil(err)
+			assertions.Nil(db.Use(&tc.timeouts))
+
+			var deadline time.Time
+			var hasDeadline bool
+			assertions.Nil(db.Callback().Query().After(timeoutStart).Before("gorm:query").Register("test:deadline", func(db *gorm.DB) {
+				deadline, hasDeadline = db.Statement.Context.Deadline()
+			}))
+
+			ctx := context.Background()
+			if tc.requestTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tc.requestTimeout)
+				defer cancel()
+			}
+			query := db.WithContext(ctx)
+			if tc.locking {
+				query = query.Clauses(clause.Locking{Strength: "UPDATE"})
+			}
+			start := time.Now()
+			var accountBalance domain.AccountBalance
+			result := query.Find(&accountBalance)
+			assertions.Nil(result.Error)
+
+			if tc.expectedDeadline == 0 {
+				assertions.False(hasDeadline)
+				return
+			}
+			assertions.True(hasDeadline)
+			assertions.WithinDuration(start.Add(tc.expectedDeadline), deadline, 100*time.Millisecond)
+			assertions.Equal(ctx, result.Statement.Context)
+		})
+	}
+}
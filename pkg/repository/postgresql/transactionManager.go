@@ -1,6 +1,8 @@
 package postgresql
 
 import (
+	"context"
+
 	"github.com/mrth1995/go-mockva/pkg/repository"
 	"gorm.io/gorm"
 )
@@ -15,7 +17,8 @@ func NewGormTransactionManager(db *gorm.DB) repository.DBTransactionManager {
 	return &GormTransactionManager{db: db}
 }
 
-// Transaction executes the given function within a GORM transaction.
-func (m *GormTransactionManager) Transaction(fc func(tx *gorm.DB) error) error {
-	return m.db.Transaction(fc)
+// Transaction executes the given function within a GORM transaction bound to ctx. Serialization
+// failures and deadlocks, raised by the statements of fc or by the commit, come back as conflicts.
+func (m *GormTransactionManager) Transaction(ctx context.Context, fc func(tx *gorm.DB) error) error {
+	return mapError(m.db.WithContext(ctx).Transaction(fc))
 }
@@ -0,0 +1,61 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	"github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/repository"
+	"github.com/mrth1995/go-mockva/pkg/tenant"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type VirtualAccountRepositoryImpl struct {
+	Connection *gorm.DB
+}
+
+func NewVirtualAccountRepository(dbConnection *gorm.DB) repository.VirtualAccountRepository {
+	return &VirtualAccountRepositoryImpl{
+		Connection: dbConnection,
+	}
+}
+
+func (r *VirtualAccountRepositoryImpl) FindByNo(ctx context.Context, virtualAccountNo string) (*domain.VirtualAccount, error) {
+	var virtualAccount domain.VirtualAccount
+	find := r.Connection.WithContext(ctx).Scopes(forTenant(ctx)).First(&virtualAccount, "virtual_account_no = ?", virtualAccountNo)
+	if find.Error != nil && find.Error == gorm.ErrRecordNotFound {
+		return nil, errors.NewVirtualAccountNotFound(virtualAccountNo)
+	}
+	if find.Error != nil {
+		return nil, find.Error
+	}
+	return &virtualAccount, nil
+}
+
+func (r *VirtualAccountRepositoryImpl) Save(ctx context.Context, virtualAccount *domain.VirtualAccount) error {
+	virtualAccount.TenantID = tenant.FromContext(ctx)
+	return r.Connection.WithContext(ctx).Save(virtualAccount).Error
+}
+
+// FindAndLockByNo locks the virtual account row until tx ends, so that concurrent payments of the
+// same bill wait for each other, even when they are served by different instances.
+func (r *VirtualAccountRepositoryImpl) FindAndLockByNo(ctx context.Context, virtualAccountNo string, tx *gorm.DB) (*domain.VirtualAccount, error) {
+	var virtualAccount domain.VirtualAccount
+	find := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Scopes(forTenant(ctx)).First(&virtualAccount, "virtual_account_no = ?", virtualAccountNo)
+	if find.Error != nil && find.Error == gorm.ErrRecordNotFound {
+		return nil, errors.NewVirtualAccountNotFound(virtualAccountNo)
+	}
+	if find.Error != nil && isLockTimeout(ctx, find.Error) {
+		return nil, errors.NewConflict("Virtual account " + virtualAccountNo + " is being paid")
+	}
+	if find.Error != nil {
+		return nil, mapError(find.Error)
+	}
+	return &virtualAccount, nil
+}
+
+func (r *VirtualAccountRepositoryImpl) Update(ctx context.Context, virtualAccount *domain.VirtualAccount, tx *gorm.DB) error {
+	virtualAccount.TenantID = tenant.FromContext(ctx)
+	return mapError(tx.WithContext(ctx).Save(virtualAccount).Error)
+}
@@ -0,0 +1,46 @@
+package repository
+
+//go:generate mockgen -destination=mock/mockTenantRepository.go -package=mock github.com/mrth1995/go-mockva/pkg/repository TenantRepository
+
+import (
+	"context"
+
+	"github.com/mrth1995/go-mockva/pkg/domain"
+)
+
+// TenantRepository defines the interface for tenant persistence operations.
+type TenantRepository interface {
+	// FindByID retrieves a tenant by its unique identifier.
+	// Parameters:
+	//   - ctx: The request context for cancellation and timeouts
+	//   - id: The tenant ID
+	// Returns:
+	//   - *domain.Tenant: The tenant if found
+	//   - error: If the tenant is not found or a database error occurs
+	FindByID(ctx context.Context, id string) (*domain.Tenant, error)
+
+	// FindAll retrieves every tenant ordered by tenant ID.
+	// Parameters:
+	//   - ctx: The request context for cancellation and timeouts
+	// Returns:
+	//   - []domain.Tenant: The tenants
+	//   - error: If a database error occurs
+	FindAll(ctx context.Context) ([]domain.Tenant, error)
+
+	// Create provisions a tenant along with its settlement accounts, in a single transaction.
+	// Parameters:
+	//   - ctx: The request context for cancellation and timeouts
+	//   - newTenant: The tenant to provision
+	// Returns:
+	//   - error: If a database error occurs
+	Create(ctx context.Context, newTenant *domain.Tenant) error
+
+	// Wipe deletes the virtual accounts, transactions and accounts of a tenant, in a single
+	// transaction. The tenant and its settlement accounts are kept, with their balances reset.
+	// Parameters:
+	//   - ctx: The request context for cancellation and timeouts
+	//   - id: The tenant ID
+	// Returns:
+	//   - error: If a database error occurs
+	Wipe(ctx context.Context, id string) error
+}
@@ -1,6 +1,10 @@
 package repository
 
-import "gorm.io/gorm"
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
 
 // DBTransactionManager defines the interface for managing database transactions.
 type DBTransactionManager interface {
@@ -8,8 +12,9 @@ type DBTransactionManager interface {
 	// If the function returns an error, the transaction is rolled back.
 	// Otherwise, the transaction is committed.
 	// Parameters:
-	//   - fc: The function to execute within the transaction
+	//   - ctx: The request context, cancelling it rolls the transaction back
+	//   - fc: The function to execute within the transaction, tx carries ctx
 	// Returns:
 	//   - error: If the transaction fails or the function returns an error
-	Transaction(fc func(tx *gorm.DB) error) error
+	Transaction(ctx context.Context, fc func(tx *gorm.DB) error) error
 }
@@ -0,0 +1,51 @@
+package repository
+
+//go:generate mockgen -destination=mock/mockVirtualAccountRepository.go -package=mock github.com/mrth1995/go-mockva/pkg/repository VirtualAccountRepository
+
+import (
+	"context"
+
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// VirtualAccountRepository defines the interface for virtual account data persistence operations.
+type VirtualAccountRepository interface {
+	// FindByNo retrieves a virtual account by its number, including deleted ones.
+	// Parameters:
+	//   - ctx: The request context for cancellation and timeouts
+	//   - virtualAccountNo: The virtual account number
+	// Returns:
+	//   - *domain.VirtualAccount: The virtual account if found
+	//   - error: If the virtual account is not found or a database error occurs
+	FindByNo(ctx context.Context, virtualAccountNo string) (*domain.VirtualAccount, error)
+
+	// Save creates the virtual account or replaces an existing one with the same number.
+	// Parameters:
+	//   - ctx: The request context for cancellation and timeouts
+	//   - virtualAccount: The virtual account to persist
+	// Returns:
+	//   - error: If a database error occurs
+	Save(ctx context.Context, virtualAccount *domain.VirtualAccount) error
+
+	// FindAndLockByNo retrieves a virtual account, including a deleted one, with a pessimistic lock held until tx ends.
+	// This method must be called within an active database transaction.
+	// Parameters:
+	//   - ctx: The request context for cancellation and timeouts
+	//   - virtualAccountNo: The virtual account number
+	//   - tx: The GORM transaction context
+	// Returns:
+	//   - *domain.VirtualAccount: The virtual account with an active row lock
+	//   - error: If the virtual account is not found, the lock wait times out or a database error occurs
+	FindAndLockByNo(ctx context.Context, virtualAccountNo string, tx *gorm.DB) (*domain.VirtualAccount, error)
+
+	// Update persists the changes of an existing virtual account within the provided transaction context.
+	// This method must be called within an active database transaction.
+	// Parameters:
+	//   - ctx: The request context for cancellation and timeouts
+	//   - virtualAccount: The virtual account to persist
+	//   - tx: The GORM transaction context
+	// Returns:
+	//   - error: If a database error occurs
+	Update(ctx context.Context, virtualAccount *domain.VirtualAccount, tx *gorm.DB) error
+}
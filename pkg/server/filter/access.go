@@ -0,0 +1,60 @@
+package filter
+
+import (
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	endpointError "github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/service"
+)
+
+// AccessController enforces the access rules of the registry on requests made on behalf of an API
+// client: the client must be enabled, call from an allowed IP and still have the scopes of the
+// route. It must run after the filters authenticating the client.
+type AccessController struct {
+	apiClientService *service.APIClientService
+	writeError       ErrorWriter
+}
+
+// NewAccessController creates a new AccessController.
+// Parameters:
+//   - apiClientService: Service providing the access rules of the API clients
+//   - writeError: Writes the response of rejected requests
+//
+// Returns:
+//   - *AccessController: A controller whose Filter method is a go-restful filter
+func NewAccessController(apiClientService *service.APIClientService, writeError ErrorWriter) *AccessController {
+	return &AccessController{
+		apiClientService: apiClientService,
+		writeError:       writeError,
+	}
+}
+
+// WithErrorWriter returns a copy of the controller writing errors with writeError.
+func (c *AccessController) WithErrorWriter(writeError ErrorWriter) *AccessController {
+	return NewAccessController(c.apiClientService, writeError)
+}
+
+// Filter rejects requests the authenticated client may not make. Anonymous requests are let through.
+func (c *AccessController) Filter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	principal, ok := auth.PrincipalFrom(request.Request.Context())
+	if !ok {
+		chain.ProcessFilter(request, response)
+		return
+	}
+	client, err := c.apiClientService.FindByID(request.Request.Context(), principal.ClientID)
+	if err != nil {
+		c.writeError(unknownClient(err), request, response)
+		return
+	}
+	if err = c.apiClientService.CheckAccess(client, request.Request.RemoteAddr); err != nil {
+		c.writeError(err, request, response)
+		return
+	}
+	scopes, _ := request.SelectedRoute().Metadata()[auth.RouteScopesKey].([]string)
+	current := &auth.Principal{ClientID: client.ID, Scopes: client.Scopes}
+	if !current.HasScopes(scopes...) {
+		c.writeError(endpointError.NewForbidden("API client "+client.ID+" no longer has the scopes of the route"), request, response)
+		return
+	}
+	chain.ProcessFilter(request, response)
+}
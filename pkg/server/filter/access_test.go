@@ -0,0 +1,87 @@
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	mockRepo "github.com/mrth1995/go-mockva/pkg/repository/mock"
+	"github.com/mrth1995/go-mockva/pkg/server/responseWriter"
+	"github.com/mrth1995/go-mockva/pkg/service"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAccessController(t *testing.T) {
+	testCases := []struct {
+		name           string
+		client         domain.APIClient
+		remoteAddr     string
+		expectedStatus int
+	}{
+		{name: "Allowed", client: domain.APIClient{Enabled: true, Scopes: []string{auth.ScopeAccountsRead}}, expectedStatus: http.StatusOK},
+		{name: "Disabled", client: domain.APIClient{Scopes: []string{auth.ScopeAccountsRead}}, expectedStatus: http.StatusForbidden},
+		{name: "Scope revoked", client: domain.APIClient{Enabled: true}, expectedStatus: http.StatusForbidden},
+		{name: "Allowed IP", client: domain.APIClient{Enabled: true, Scopes: []string{auth.ScopeAccountsRead}, AllowedIPs: []string{"10.0.0.0/8"}},
+			remoteAddr: "10.1.2.3:51234", expectedStatus: http.StatusOK},
+		{name: "Other IP", client: domain.APIClient{Enabled: true, Scopes: []string{auth.ScopeAccountsRead}, AllowedIPs: []string{"10.0.0.0/8"}},
+			remoteAddr: "203.0.113.7:51234", expectedStatus: http.StatusForbidden},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc.client.ID = testClientID
+			accessController := newTestAccessController(ctrl, &tc.client)
+			recorder := serveAs(accessController.Filter, tc.remoteAddr)
+			require.Equal(t, tc.expectedStatus, recorder.Code)
+		})
+	}
+}
+
+func TestAccessController_Anonymous(t *testing.T) {
+	accessController := NewAccessController(service.NewAPIClientService(nil, nil), responseWriter.WriteError)
+
+	ws := new(restful.WebService)
+	ws.Filter(accessController.Filter)
+	ws.Route(ws.GET("/version").To(func(request *restful.Request, response *restful.Response) {}))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	recorder := httptest.NewRecorder()
+	container.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/version", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func newTestAccessController(ctrl *gomock.Controller, client *domain.APIClient) *AccessController {
+	apiClientRepo := mockRepo.NewMockAPIClientRepository(ctrl)
+	apiClientRepo.EXPECT().FindByID(gomock.Any(), testClientID).Return(client, nil).AnyTimes()
+	return NewAccessController(service.NewAPIClientService(apiClientRepo, nil), responseWriter.WriteError)
+}
+
+// serveAs runs a GET /accounts request made on behalf of testClientID through filter.
+func serveAs(filter restful.FilterFunction, remoteAddr string) *httptest.ResponseRecorder {
+	ws := new(restful.WebService)
+	ws.Filter(func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		withPrincipal(request, testClientID, []string{auth.ScopeAccountsRead})
+		chain.ProcessFilter(request, response)
+	})
+	ws.Filter(filter)
+	ws.Route(ws.GET("/accounts").
+		To(func(request *restful.Request, response *restful.Response) {}).
+		Metadata(auth.RouteScopesKey, []string{auth.ScopeAccountsRead}))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	httpRequest := httptest.NewRequest(http.MethodGet, "/accounts", nil)
+	if remoteAddr != "" {
+		httpRequest.RemoteAddr = remoteAddr
+	}
+	recorder := httptest.NewRecorder()
+	container.ServeHTTP(recorder, httpRequest)
+	return recorder
+}
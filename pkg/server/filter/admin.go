@@ -0,0 +1,55 @@
+package filter
+
+import (
+	"crypto/subtle"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	endpointError "github.com/mrth1995/go-mockva/pkg/errors"
+)
+
+const HeaderAdminKey = "X-ADMIN-KEY"
+
+// AdminGuard requires the admin key in X-ADMIN-KEY on the routes marked with auth.RouteAdminKey.
+// Without an admin key the admin routes are closed to everyone.
+type AdminGuard struct {
+	adminKey   string
+	writeError ErrorWriter
+}
+
+// NewAdminGuard creates a new AdminGuard.
+// Parameters:
+//   - adminKey: The admin key, admin routes are rejected when empty
+//   - writeError: Writes the response of rejected requests
+//
+// Returns:
+//   - *AdminGuard: A guard whose Filter method is a go-restful filter
+func NewAdminGuard(adminKey string, writeError ErrorWriter) *AdminGuard {
+	return &AdminGuard{
+		adminKey:   adminKey,
+		writeError: writeError,
+	}
+}
+
+// Filter rejects admin requests without the admin key.
+func (g *AdminGuard) Filter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	admin, _ := request.SelectedRoute().Metadata()[auth.RouteAdminKey].(bool)
+	if !admin {
+		chain.ProcessFilter(request, response)
+		return
+	}
+	if g.adminKey == "" {
+		g.writeError(endpointError.NewForbidden("admin routes are disabled, ADMIN_API_KEY is not set"), request, response)
+		return
+	}
+	adminKey := request.HeaderParameter(HeaderAdminKey)
+	if adminKey == "" {
+		g.writeError(endpointError.NewUnauthorized("missing "+HeaderAdminKey+" header"), request, response)
+		return
+	}
+	if subtle.ConstantTimeCompare([]byte(adminKey), []byte(g.adminKey)) != 1 {
+		g.writeError(endpointError.NewForbidden("invalid admin key"), request, response)
+		return
+	}
+	chain.ProcessFilter(request, response)
+}
@@ -0,0 +1,47 @@
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	"github.com/mrth1995/go-mockva/pkg/server/responseWriter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminGuard(t *testing.T) {
+	testCases := []struct {
+		name           string
+		adminKey       string
+		path           string
+		header         string
+		expectedStatus int
+	}{
+		{name: "Valid key", adminKey: "secret", path: "/admin", header: "secret", expectedStatus: http.StatusOK},
+		{name: "Missing key", adminKey: "secret", path: "/admin", expectedStatus: http.StatusUnauthorized},
+		{name: "Invalid key", adminKey: "secret", path: "/admin", header: "guess", expectedStatus: http.StatusForbidden},
+		{name: "No admin key configured", path: "/admin", header: "anything", expectedStatus: http.StatusForbidden},
+		{name: "Other route without admin key configured", path: "/version", expectedStatus: http.StatusOK},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := new(restful.WebService)
+			ws.Filter(NewAdminGuard(tc.adminKey, responseWriter.WriteError).Filter)
+			noop := func(request *restful.Request, response *restful.Response) {}
+			ws.Route(ws.GET("/admin").To(noop).Metadata(auth.RouteAdminKey, true))
+			ws.Route(ws.GET("/version").To(noop))
+			container := restful.NewContainer()
+			container.Add(ws)
+
+			request := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			if tc.header != "" {
+				request.Header.Set(HeaderAdminKey, tc.header)
+			}
+			recorder := httptest.NewRecorder()
+			container.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatus, recorder.Code)
+		})
+	}
+}
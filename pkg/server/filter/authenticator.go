@@ -0,0 +1,77 @@
+package filter
+
+import (
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	endpointError "github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/service"
+)
+
+const (
+	HeaderClientID = "X-CLIENT-ID"
+	HeaderAPIKey   = "X-API-KEY"
+)
+
+// Authenticator requires a valid bearer token, or an X-CLIENT-ID and X-API-KEY pair, on the routes
+// declaring the scopes they need in their auth.RouteScopesKey metadata.
+type Authenticator struct {
+	tokenService     *service.TokenService
+	apiClientService *service.APIClientService
+	writeError       ErrorWriter
+}
+
+// NewAuthenticator creates a new Authenticator.
+// Parameters:
+//   - tokenService: Service validating the access tokens
+//   - apiClientService: Service verifying the API secrets
+//   - writeError: Writes the response of rejected requests
+//
+// Returns:
+//   - *Authenticator: An authenticator whose Filter method is a go-restful filter
+func NewAuthenticator(tokenService *service.TokenService, apiClientService *service.APIClientService, writeError ErrorWriter) *Authenticator {
+	return &Authenticator{
+		tokenService:     tokenService,
+		apiClientService: apiClientService,
+		writeError:       writeError,
+	}
+}
+
+// Filter authenticates the request and makes its auth.Principal available through the request context.
+// A principal already set by an earlier filter, e.g. the SignatureVerifier, is replaced.
+func (a *Authenticator) Filter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	scopes, _ := request.SelectedRoute().Metadata()[auth.RouteScopesKey].([]string)
+	if len(scopes) == 0 {
+		chain.ProcessFilter(request, response)
+		return
+	}
+	var principal *auth.Principal
+	if token := accessToken(request); token != "" {
+		var err error
+		principal, err = a.tokenService.Authenticate(token)
+		if err != nil {
+			response.AddHeader("WWW-Authenticate", `Bearer realm="mockva", error="invalid_token"`)
+			a.writeError(err, request, response)
+			return
+		}
+	} else if apiKey := request.HeaderParameter(HeaderAPIKey); apiKey != "" {
+		client, err := a.apiClientService.Authenticate(request.Request.Context(), request.HeaderParameter(HeaderClientID), apiKey)
+		if err != nil {
+			a.writeError(err, request, response)
+			return
+		}
+		principal = &auth.Principal{ClientID: client.ID, Scopes: client.Scopes}
+	} else {
+		response.AddHeader("WWW-Authenticate", `Bearer realm="mockva"`)
+		a.writeError(endpointError.NewUnauthorized("missing bearer token or API key"), request, response)
+		return
+	}
+	if !principal.HasScopes(scopes...) {
+		response.AddHeader("WWW-Authenticate", `Bearer realm="mockva", error="insufficient_scope", scope="`+auth.FormatScope(scopes)+`"`)
+		a.writeError(endpointError.NewForbidden("client lacks scope "+strings.Join(scopes, ", ")), request, response)
+		return
+	}
+	withPrincipal(request, principal.ClientID, principal.Scopes)
+	chain.ProcessFilter(request, response)
+}
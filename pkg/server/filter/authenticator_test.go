@@ -0,0 +1,101 @@
+package filter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	mockRepo "github.com/mrth1995/go-mockva/pkg/repository/mock"
+	"github.com/mrth1995/go-mockva/pkg/server/responseWriter"
+	"github.com/mrth1995/go-mockva/pkg/service"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthenticator(t *testing.T) {
+	key, err := auth.LoadSigningKey("")
+	require.NoError(t, err)
+	tokenIssuer, err := auth.NewTokenIssuer(key, time.Minute, time.Hour)
+	require.NoError(t, err)
+	expiredIssuer, err := auth.NewTokenIssuer(key, -time.Minute, time.Hour)
+	require.NoError(t, err)
+
+	token, err := tokenIssuer.Issue(testClientID, []string{auth.ScopeAccountsRead})
+	require.NoError(t, err)
+	expired, err := expiredIssuer.Issue(testClientID, []string{auth.ScopeAccountsRead})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name              string
+		path              string
+		authorization     string
+		apiKey            string
+		expectedStatus    int
+		expectedChallenge string
+	}{
+		{name: "Valid token", path: "/accounts", authorization: "Bearer " + token.AccessToken, expectedStatus: http.StatusOK},
+		{name: "Route without scopes", path: "/version", expectedStatus: http.StatusOK},
+		{name: "Missing token", path: "/accounts", expectedStatus: http.StatusUnauthorized, expectedChallenge: `Bearer realm="mockva"`},
+		{name: "Valid API key", path: "/accounts", apiKey: testSecret, expectedStatus: http.StatusOK},
+		{name: "Wrong API key", path: "/accounts", apiKey: "other", expectedStatus: http.StatusUnauthorized},
+		{name: "Expired token", path: "/accounts", authorization: "Bearer " + expired.AccessToken, expectedStatus: http.StatusUnauthorized,
+			expectedChallenge: `Bearer realm="mockva", error="invalid_token"`},
+		{name: "Refresh token", path: "/accounts", authorization: "Bearer " + token.RefreshToken, expectedStatus: http.StatusUnauthorized,
+			expectedChallenge: `Bearer realm="mockva", error="invalid_token"`},
+		{name: "Insufficient scope", path: "/transfers", authorization: "Bearer " + token.AccessToken, expectedStatus: http.StatusForbidden,
+			expectedChallenge: `Bearer realm="mockva", error="insufficient_scope", scope="transfers:write"`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			apiClientRepo := mockRepo.NewMockAPIClientRepository(ctrl)
+			apiClientRepo.EXPECT().FindByID(gomock.Any(), testClientID).
+				Return(&domain.APIClient{ID: testClientID, SecretHash: sha256Hex(testSecret), Scopes: []string{auth.ScopeAccountsRead}}, nil).AnyTimes()
+			apiClientService := service.NewAPIClientService(apiClientRepo, nil)
+			authenticator := NewAuthenticator(service.NewTokenService(apiClientService, tokenIssuer), apiClientService, responseWriter.WriteError)
+
+			var principal *auth.Principal
+			ws := new(restful.WebService)
+			ws.Filter(authenticator.Filter)
+			handler := func(request *restful.Request, response *restful.Response) {
+				principal, _ = auth.PrincipalFrom(request.Request.Context())
+			}
+			ws.Route(ws.GET("/accounts").To(handler).Metadata(auth.RouteScopesKey, []string{auth.ScopeAccountsRead}))
+			ws.Route(ws.GET("/transfers").To(handler).Metadata(auth.RouteScopesKey, []string{auth.ScopeTransfersWrite}))
+			ws.Route(ws.GET("/version").To(handler))
+			container := restful.NewContainer()
+			container.Add(ws)
+
+			httpRequest := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			if tc.authorization != "" {
+				httpRequest.Header.Set(HeaderAuthorization, tc.authorization)
+			}
+			if tc.apiKey != "" {
+				httpRequest.Header.Set(HeaderClientID, testClientID)
+				httpRequest.Header.Set(HeaderAPIKey, tc.apiKey)
+			}
+			recorder := httptest.NewRecorder()
+			container.ServeHTTP(recorder, httpRequest)
+
+			assertions := require.New(t)
+			assertions.Equal(tc.expectedStatus, recorder.Code)
+			assertions.Equal(tc.expectedChallenge, recorder.Header().Get("WWW-Authenticate"))
+			if tc.expectedStatus == http.StatusOK && tc.path == "/accounts" {
+				assertions.Equal(testClientID, principal.ClientID)
+			}
+		})
+	}
+}
+
+func sha256Hex(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
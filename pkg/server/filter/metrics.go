@@ -0,0 +1,17 @@
+package filter
+
+import (
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/metrics"
+)
+
+// Metrics records the count and latency of the requests by route and response status. It should
+// be the first filter of a web service so that requests rejected by the other filters are recorded.
+func Metrics(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	start := time.Now()
+	chain.ProcessFilter(request, response)
+	route := request.SelectedRoute()
+	metrics.ObserveRequest(route.Method(), route.Path(), response.StatusCode(), time.Since(start))
+}
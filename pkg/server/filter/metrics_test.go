@@ -0,0 +1,37 @@
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	"github.com/mrth1995/go-mockva/pkg/metrics"
+	"github.com/mrth1995/go-mockva/pkg/server/responseWriter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	ws := new(restful.WebService)
+	ws.Path("/metrics-test")
+	ws.Filter(Metrics)
+	ws.Filter(NewAdminGuard("secret", responseWriter.WriteError).Filter)
+	ws.Route(ws.GET("/items/{itemId}").To(func(request *restful.Request, response *restful.Response) {}))
+	ws.Route(ws.GET("/admin").To(func(request *restful.Request, response *restful.Response) {}).Metadata(auth.RouteAdminKey, true))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	for _, path := range []string{"/metrics-test/items/1", "/metrics-test/items/2", "/metrics-test/admin"} {
+		container.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	recorder := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assertions := require.New(t)
+	assertions.Contains(recorder.Body.String(),
+		`mockva_http_requests_total{method="GET",route="/metrics-test/items/{itemId}",status="200"} 2`, "path parameters share a series")
+	assertions.Contains(recorder.Body.String(),
+		`mockva_http_requests_total{method="GET",route="/metrics-test/admin",status="401"} 1`, "rejected requests are recorded")
+}
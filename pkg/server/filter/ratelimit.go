@@ -0,0 +1,112 @@
+package filter
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	endpointError "github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/ratelimit"
+	"github.com/mrth1995/go-mockva/pkg/service"
+)
+
+const HeaderRetryAfter = "Retry-After"
+
+// RateLimits are the request quotas enforced by a RateLimiter, in requests per minute. A limit of 0
+// leaves what it applies to unlimited.
+type RateLimits struct {
+	// Global is shared by every request made to the instance.
+	Global int
+	// Routes are shared by every request made to a route, keyed by ratelimit.RouteKey.
+	Routes map[string]int
+	// ClientConcurrency is how many requests each API client may have in flight.
+	ClientConcurrency int
+}
+
+// RateLimiter throttles requests with token buckets: one for the whole instance, one per route and
+// one per API client, refilled at the rate limit of the client in the registry. Requests over a quota
+// are rejected with 429 and a Retry-After header telling when to retry. It must run after the
+// filters authenticating the client.
+type RateLimiter struct {
+	apiClientService *service.APIClientService
+	limits           RateLimits
+	buckets          *ratelimit.Buckets
+	inFlight         *ratelimit.Concurrency
+	writeError       ErrorWriter
+}
+
+// NewRateLimiter creates a new RateLimiter.
+// Parameters:
+//   - apiClientService: Service providing the rate limits of the API clients
+//   - limits: The global, per route and concurrency limits
+//   - writeError: Writes the response of rejected requests
+//
+// Returns:
+//   - *RateLimiter: A limiter whose Filter method is a go-restful filter
+func NewRateLimiter(apiClientService *service.APIClientService, limits RateLimits, writeError ErrorWriter) *RateLimiter {
+	return &RateLimiter{
+		apiClientService: apiClientService,
+		limits:           limits,
+		buckets:          ratelimit.NewBuckets(),
+		inFlight:         ratelimit.NewConcurrency(),
+		writeError:       writeError,
+	}
+}
+
+// WithErrorWriter returns a copy of the limiter writing errors with writeError. The copy shares the
+// quotas of the limiter, so the instance and each client have one quota across web services.
+func (l *RateLimiter) WithErrorWriter(writeError ErrorWriter) *RateLimiter {
+	return &RateLimiter{
+		apiClientService: l.apiClientService,
+		limits:           l.limits,
+		buckets:          l.buckets,
+		inFlight:         l.inFlight,
+		writeError:       writeError,
+	}
+}
+
+// Filter rejects the requests over a quota. The quota of the client is checked first, so a client
+// over its own quota does not use up the quotas shared with the others.
+func (l *RateLimiter) Filter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	clientID := ""
+	if principal, ok := auth.PrincipalFrom(request.Request.Context()); ok {
+		client, err := l.apiClientService.FindByID(request.Request.Context(), principal.ClientID)
+		if err != nil {
+			l.writeError(unknownClient(err), request, response)
+			return
+		}
+		clientID = client.ID
+		if retryAfter := l.buckets.Take("client:"+client.ID, client.RateLimit); retryAfter > 0 {
+			l.reject(retryAfter, "API client "+client.ID+" exceeded its rate limit", request, response)
+			return
+		}
+	}
+	route := request.SelectedRoute()
+	routeKey := ratelimit.RouteKey(route.Method(), route.Path())
+	if retryAfter := l.buckets.Take("route:"+routeKey, l.limits.Routes[routeKey]); retryAfter > 0 {
+		l.reject(retryAfter, routeKey+" exceeded its rate limit", request, response)
+		return
+	}
+	if retryAfter := l.buckets.Take("global", l.limits.Global); retryAfter > 0 {
+		l.reject(retryAfter, "mockva exceeded its rate limit", request, response)
+		return
+	}
+	if clientID == "" {
+		chain.ProcessFilter(request, response)
+		return
+	}
+	if !l.inFlight.Acquire(clientID, l.limits.ClientConcurrency) {
+		l.reject(time.Second, "API client "+clientID+" has too many requests in flight", request, response)
+		return
+	}
+	defer l.inFlight.Release(clientID)
+	chain.ProcessFilter(request, response)
+}
+
+func (l *RateLimiter) reject(retryAfter time.Duration, message string, request *restful.Request, response *restful.Response) {
+	seconds := max(1, int(math.Ceil(retryAfter.Seconds())))
+	response.AddHeader(HeaderRetryAfter, strconv.Itoa(seconds))
+	l.writeError(endpointError.NewTooManyRequests(message), request, response)
+}
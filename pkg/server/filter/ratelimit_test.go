@@ -0,0 +1,84 @@
+package filter
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	mockRepo "github.com/mrth1995/go-mockva/pkg/repository/mock"
+	"github.com/mrth1995/go-mockva/pkg/server/responseWriter"
+	"github.com/mrth1995/go-mockva/pkg/service"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRateLimiter_Client(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := &domain.APIClient{ID: testClientID, Enabled: true, Scopes: []string{auth.ScopeAccountsRead}, RateLimit: 2}
+	rateLimiter := newTestRateLimiter(ctrl, client, RateLimits{})
+	snapRateLimiter := rateLimiter.WithErrorWriter(responseWriter.WriteError)
+
+	assertions := require.New(t)
+	assertions.Equal(http.StatusOK, serveAs(rateLimiter.Filter, "").Code)
+	assertions.Equal(http.StatusOK, serveAs(snapRateLimiter.Filter, "").Code)
+	recorder := serveAs(rateLimiter.Filter, "")
+	assertions.Equal(http.StatusTooManyRequests, recorder.Code, "the limit is shared across web services")
+	assertions.Equal("30", recorder.Header().Get(HeaderRetryAfter))
+
+	client.RateLimit = 0
+	assertions.Equal(http.StatusOK, serveAs(rateLimiter.Filter, "").Code, "the new limit applies immediately")
+}
+
+func TestRateLimiter_Route(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := &domain.APIClient{ID: testClientID, Enabled: true}
+	rateLimiter := newTestRateLimiter(ctrl, client, RateLimits{Routes: map[string]int{"GET /accounts": 1}})
+
+	assertions := require.New(t)
+	assertions.Equal(http.StatusOK, serveAs(rateLimiter.Filter, "").Code)
+	recorder := serveAs(rateLimiter.Filter, "")
+	assertions.Equal(http.StatusTooManyRequests, recorder.Code)
+	assertions.Equal("60", recorder.Header().Get(HeaderRetryAfter))
+}
+
+func TestRateLimiter_Global(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := &domain.APIClient{ID: testClientID, Enabled: true}
+	rateLimiter := newTestRateLimiter(ctrl, client, RateLimits{Global: 2})
+
+	assertions := require.New(t)
+	assertions.Equal(http.StatusOK, serveAs(rateLimiter.Filter, "").Code)
+	assertions.Equal(http.StatusOK, serveAs(rateLimiter.Filter, "").Code)
+	assertions.Equal(http.StatusTooManyRequests, serveAs(rateLimiter.Filter, "").Code)
+}
+
+func TestRateLimiter_ClientConcurrency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := &domain.APIClient{ID: testClientID, Enabled: true}
+	rateLimiter := newTestRateLimiter(ctrl, client, RateLimits{ClientConcurrency: 1})
+
+	assertions := require.New(t)
+	assertions.True(rateLimiter.inFlight.Acquire(testClientID, 1), "a request of the client is in flight")
+	recorder := serveAs(rateLimiter.Filter, "")
+	assertions.Equal(http.StatusTooManyRequests, recorder.Code)
+	assertions.Equal("1", recorder.Header().Get(HeaderRetryAfter))
+
+	rateLimiter.inFlight.Release(testClientID)
+	assertions.Equal(http.StatusOK, serveAs(rateLimiter.Filter, "").Code)
+	assertions.Equal(http.StatusOK, serveAs(rateLimiter.Filter, "").Code, "completed requests are no longer in flight")
+}
+
+func newTestRateLimiter(ctrl *gomock.Controller, client *domain.APIClient, limits RateLimits) *RateLimiter {
+	apiClientRepo := mockRepo.NewMockAPIClientRepository(ctrl)
+	apiClientRepo.EXPECT().FindByID(gomock.Any(), testClientID).Return(client, nil).AnyTimes()
+	return NewRateLimiter(service.NewAPIClientService(apiClientRepo, nil), limits, responseWriter.WriteError)
+}
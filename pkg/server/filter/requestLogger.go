@@ -0,0 +1,79 @@
+package filter
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	"github.com/mrth1995/go-mockva/pkg/logging"
+	"github.com/mrth1995/go-mockva/pkg/tenant"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	HeaderRequestID = "X-Request-ID"
+
+	maxRequestIDLength = 128
+)
+
+// RequestLogger assigns each request the X-Request-ID sent by the client, or a new one, returns it
+// in the response and stores a logger tagged with it in the request context. Once the request is
+// processed it writes its access log line. It should follow the Tracing filter so that the log
+// lines carry the trace ID too.
+func RequestLogger(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	start := time.Now()
+	requestID := request.HeaderParameter(HeaderRequestID)
+	if !validRequestID(requestID) {
+		requestID = newRequestID()
+	}
+	response.AddHeader(HeaderRequestID, requestID)
+
+	logger := logrus.WithField("requestId", requestID)
+	if spanContext := trace.SpanContextFromContext(request.Request.Context()); spanContext.HasTraceID() {
+		logger = logger.WithField("traceId", spanContext.TraceID().String())
+	}
+	request.Request = request.Request.WithContext(logging.NewContext(request.Request.Context(), logger, requestID))
+
+	chain.ProcessFilter(request, response)
+
+	ctx := request.Request.Context()
+	route := request.SelectedRoute()
+	fields := logrus.Fields{
+		"method":    route.Method(),
+		"route":     route.Path(),
+		"path":      request.Request.URL.Path,
+		"status":    response.StatusCode(),
+		"latencyMs": time.Since(start).Milliseconds(),
+		"tenantId":  tenant.FromContext(ctx),
+	}
+	if principal, ok := auth.PrincipalFrom(ctx); ok {
+		fields["clientId"] = principal.ClientID
+	}
+	if accountIDs := logging.AccountIDs(ctx); len(accountIDs) > 0 {
+		fields["accountIds"] = accountIDs
+	}
+	logger.WithFields(fields).Info("access")
+}
+
+// validRequestID accepts the printable ASCII IDs of reasonable length, so that clients cannot
+// forge log lines through the header.
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range requestID {
+		if c < 0x21 || c > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	var id [16]byte
+	_, _ = rand.Read(id[:])
+	return hex.EncodeToString(id[:])
+}
@@ -0,0 +1,71 @@
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/logging"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestLogger(t *testing.T) {
+	testCases := []struct {
+		name              string
+		requestID         string
+		expectedRequestID string
+	}{
+		{name: "Propagated", requestID: "req-123", expectedRequestID: "req-123"},
+		{name: "Generated"},
+		{name: "Invalid", requestID: "req 123\nforged"},
+		{name: "Too long", requestID: strings.Repeat("a", maxRequestIDLength+1)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hook := test.NewGlobal()
+			defer hook.Reset()
+
+			var handlerRequestID string
+			ws := new(restful.WebService)
+			ws.Filter(RequestLogger)
+			ws.Route(ws.GET("/accounts/{accountId}").To(func(request *restful.Request, response *restful.Response) {
+				ctx := request.Request.Context()
+				handlerRequestID = logging.RequestID(ctx)
+				logging.AddAccountIDs(ctx, request.PathParameter("accountId"))
+				response.WriteHeader(http.StatusNotFound)
+			}))
+			container := restful.NewContainer()
+			container.Add(ws)
+
+			httpRequest := httptest.NewRequest(http.MethodGet, "/accounts/1234", nil)
+			if tc.requestID != "" {
+				httpRequest.Header.Set(HeaderRequestID, tc.requestID)
+			}
+			recorder := httptest.NewRecorder()
+			container.ServeHTTP(recorder, httpRequest)
+
+			assertions := require.New(t)
+			requestID := recorder.Header().Get(HeaderRequestID)
+			if tc.expectedRequestID != "" {
+				assertions.Equal(tc.expectedRequestID, requestID)
+			} else {
+				assertions.Len(requestID, 32)
+			}
+			assertions.Equal(requestID, handlerRequestID)
+
+			entry := hook.LastEntry()
+			assertions.NotNil(entry)
+			assertions.Equal(logrus.InfoLevel, entry.Level)
+			assertions.Equal("access", entry.Message)
+			assertions.Equal(requestID, entry.Data["requestId"])
+			assertions.Equal(http.MethodGet, entry.Data["method"])
+			assertions.Equal("/accounts/{accountId}", entry.Data["route"])
+			assertions.Equal(http.StatusNotFound, entry.Data["status"])
+			assertions.Equal([]string{"1234"}, entry.Data["accountIds"])
+		})
+	}
+}
@@ -0,0 +1,180 @@
+// Package filter contains go-restful filters guarding the mockva web services.
+package filter
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	endpointError "github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/logging"
+	"github.com/mrth1995/go-mockva/pkg/service"
+	"github.com/mrth1995/go-mockva/pkg/signature"
+)
+
+const (
+	HeaderTimestamp     = "X-TIMESTAMP"
+	HeaderSignature     = "X-SIGNATURE"
+	HeaderClientKey     = "X-CLIENT-KEY"
+	HeaderPartnerID     = "X-PARTNER-ID"
+	HeaderAuthorization = "Authorization"
+)
+
+// ErrorWriter writes a rejected request in the error format of the web service being protected.
+type ErrorWriter func(e error, request *restful.Request, response *restful.Response)
+
+// SignatureVerifier verifies X-SIGNATURE headers with the keys of the registered API clients.
+type SignatureVerifier struct {
+	apiClientService *service.APIClientService
+	clockSkew        time.Duration
+	writeError       ErrorWriter
+	now              func() time.Time
+}
+
+// NewSignatureVerifier creates a new SignatureVerifier.
+// Parameters:
+//   - apiClientService: Service providing the keys of the API clients
+//   - clockSkew: How far X-TIMESTAMP may be from the server clock, in either direction
+//   - writeError: Writes the response of rejected requests
+//
+// Returns:
+//   - *SignatureVerifier: A verifier whose Asymmetric and Symmetric methods are go-restful filters
+func NewSignatureVerifier(apiClientService *service.APIClientService, clockSkew time.Duration, writeError ErrorWriter) *SignatureVerifier {
+	return &SignatureVerifier{
+		apiClientService: apiClientService,
+		clockSkew:        clockSkew,
+		writeError:       writeError,
+		now:              time.Now,
+	}
+}
+
+// Filter verifies the request with Asymmetric or Symmetric, depending on the signature.RouteKindKey
+// metadata of the route.
+func (v *SignatureVerifier) Filter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	if request.SelectedRoute().Metadata()[signature.RouteKindKey] == signature.KindAsymmetric {
+		v.Asymmetric(request, response, chain)
+		return
+	}
+	v.Symmetric(request, response, chain)
+}
+
+// Asymmetric verifies token requests: an RSA or ECDSA signature of the X-CLIENT-KEY client ID and
+// X-TIMESTAMP, checked with the public key of the client.
+func (v *SignatureVerifier) Asymmetric(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	clientID := request.HeaderParameter(HeaderClientKey)
+	timestamp, sig, err := v.readHeaders(request, HeaderClientKey)
+	if err != nil {
+		v.reject(err, request, response)
+		return
+	}
+	client, err := v.apiClientService.FindByID(request.Request.Context(), clientID)
+	if err != nil {
+		v.reject(unknownClient(err), request, response)
+		return
+	}
+	if client.PublicKey == "" {
+		v.reject(endpointError.NewUnauthorized("client has no public key"), request, response)
+		return
+	}
+	publicKey, err := signature.ParsePublicKey(client.PublicKey)
+	if err != nil {
+		logging.FromContext(request.Request.Context()).Errorf("invalid public key of client %v: %v", clientID, err)
+		v.reject(endpointError.NewUnauthorized("client has an invalid public key"), request, response)
+		return
+	}
+	if err = signature.VerifyAsymmetric(publicKey, signature.AsymmetricStringToSign(clientID, timestamp), sig); err != nil {
+		v.reject(endpointError.NewUnauthorized("invalid signature"), request, response)
+		return
+	}
+	withPrincipal(request, client.ID, client.Scopes)
+	chain.ProcessFilter(request, response)
+}
+
+// Symmetric verifies service requests: an HMAC-SHA512 signature of the method, path, access token,
+// body hash and X-TIMESTAMP, keyed with the secret of the X-PARTNER-ID client.
+func (v *SignatureVerifier) Symmetric(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	clientID := request.HeaderParameter(HeaderPartnerID)
+	timestamp, sig, err := v.readHeaders(request, HeaderPartnerID)
+	if err != nil {
+		v.reject(err, request, response)
+		return
+	}
+	client, err := v.apiClientService.FindByID(request.Request.Context(), clientID)
+	if err != nil {
+		v.reject(unknownClient(err), request, response)
+		return
+	}
+	if client.ClientSecret == "" {
+		v.reject(endpointError.NewUnauthorized("client has no client secret"), request, response)
+		return
+	}
+	body, err := io.ReadAll(request.Request.Body)
+	if err != nil {
+		v.reject(endpointError.NewValidationErrorf("unable to read request body: %v", err), request, response)
+		return
+	}
+	request.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	stringToSign, err := signature.SymmetricStringToSign(request.Request.Method, request.Request.URL.RequestURI(),
+		accessToken(request), body, timestamp)
+	if err != nil {
+		v.reject(endpointError.NewValidationError(err.Error()), request, response)
+		return
+	}
+	if err = signature.VerifySymmetric(client.ClientSecret, stringToSign, sig); err != nil {
+		v.reject(endpointError.NewUnauthorized("invalid signature"), request, response)
+		return
+	}
+	withPrincipal(request, client.ID, client.Scopes)
+	chain.ProcessFilter(request, response)
+}
+
+// readHeaders checks that the client header, X-TIMESTAMP and X-SIGNATURE are present and that
+// X-TIMESTAMP is within the clock skew, then returns the timestamp and the signature.
+func (v *SignatureVerifier) readHeaders(request *restful.Request, clientHeader string) (string, string, error) {
+	for _, header := range []string{clientHeader, HeaderTimestamp, HeaderSignature} {
+		if request.HeaderParameter(header) == "" {
+			return "", "", endpointError.NewUnauthorized("missing " + header + " header")
+		}
+	}
+	timestamp := request.HeaderParameter(HeaderTimestamp)
+	signedAt, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return "", "", endpointError.NewUnauthorized(HeaderTimestamp + " must be an ISO 8601 timestamp")
+	}
+	if skew := v.now().Sub(signedAt).Abs(); skew > v.clockSkew {
+		return "", "", endpointError.NewUnauthorized(HeaderTimestamp + " is outside the allowed clock skew")
+	}
+	return timestamp, request.HeaderParameter(HeaderSignature), nil
+}
+
+func (v *SignatureVerifier) reject(e error, request *restful.Request, response *restful.Response) {
+	logging.FromContext(request.Request.Context()).Infof("Rejected %v %v: %v", request.Request.Method, request.Request.URL.Path, e)
+	v.writeError(e, request, response)
+}
+
+// withPrincipal makes the verified client available through the request context.
+func withPrincipal(request *restful.Request, clientID string, scopes []string) {
+	principal := &auth.Principal{ClientID: clientID, Scopes: scopes}
+	request.Request = request.Request.WithContext(auth.WithPrincipal(request.Request.Context(), principal))
+}
+
+// unknownClient hides whether the client exists from the caller.
+func unknownClient(err error) error {
+	if endpointError.Is(err, endpointError.ErrAPIClientNotFound) {
+		return endpointError.NewUnauthorized("unknown client")
+	}
+	return err
+}
+
+// accessToken returns the bearer token of the request, or an empty string when it has none.
+func accessToken(request *restful.Request) string {
+	token, found := strings.CutPrefix(request.HeaderParameter(HeaderAuthorization), "Bearer ")
+	if !found {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
@@ -0,0 +1,127 @@
+package filter
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	mockRepo "github.com/mrth1995/go-mockva/pkg/repository/mock"
+	"github.com/mrth1995/go-mockva/pkg/server/responseWriter"
+	"github.com/mrth1995/go-mockva/pkg/service"
+	"github.com/mrth1995/go-mockva/pkg/signature"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testClientID = "client-001"
+	testSecret   = "secret"
+	testPath     = "/mockva/snap/v1.0/transfer-va/inquiry"
+	testBody     = `{"customerNo": "002"}`
+)
+
+func TestSignatureVerifier_Symmetric(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	timestamp := now.Format(time.RFC3339)
+	stringToSign, err := signature.SymmetricStringToSign(http.MethodPost, testPath, "", []byte(testBody), timestamp)
+	require.NoError(t, err)
+	validSignature := signature.SignSymmetric(testSecret, stringToSign)
+
+	testCases := []struct {
+		name           string
+		timestamp      string
+		signature      string
+		expectedStatus int
+	}{
+		{name: "Valid signature", timestamp: timestamp, signature: validSignature, expectedStatus: http.StatusOK},
+		{name: "Wrong secret", timestamp: timestamp, signature: signature.SignSymmetric("other", stringToSign), expectedStatus: http.StatusUnauthorized},
+		{name: "Outside clock skew", timestamp: now.Add(-10 * time.Minute).Format(time.RFC3339), signature: validSignature, expectedStatus: http.StatusUnauthorized},
+		{name: "Missing signature", timestamp: timestamp, expectedStatus: http.StatusUnauthorized},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			apiClientRepo := mockRepo.NewMockAPIClientRepository(ctrl)
+			apiClientRepo.EXPECT().FindByID(gomock.Any(), testClientID).
+				Return(&domain.APIClient{ID: testClientID, ClientSecret: testSecret}, nil).AnyTimes()
+			verifier := newTestVerifier(apiClientRepo, now)
+
+			httpRequest := httptest.NewRequest(http.MethodPost, testPath, strings.NewReader(testBody))
+			httpRequest.Header.Set(HeaderPartnerID, testClientID)
+			httpRequest.Header.Set(HeaderTimestamp, tc.timestamp)
+			httpRequest.Header.Set(HeaderSignature, tc.signature)
+
+			recorder, body := serve(verifier.Symmetric, httpRequest)
+			require.Equal(t, tc.expectedStatus, recorder.Code)
+			if tc.expectedStatus == http.StatusOK {
+				require.Equal(t, testBody, body, "the body is still readable by the handler")
+			}
+		})
+	}
+}
+
+func TestSignatureVerifier_Asymmetric(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	require.NoError(t, err)
+	publicKey := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	timestamp := now.Format(time.RFC3339)
+	validSignature, err := signature.SignAsymmetric(privateKey, signature.AsymmetricStringToSign(testClientID, timestamp))
+	require.NoError(t, err)
+
+	apiClientRepo := mockRepo.NewMockAPIClientRepository(ctrl)
+	apiClientRepo.EXPECT().FindByID(gomock.Any(), testClientID).
+		Return(&domain.APIClient{ID: testClientID, PublicKey: publicKey}, nil).AnyTimes()
+	verifier := newTestVerifier(apiClientRepo, now)
+
+	for sig, expectedStatus := range map[string]int{validSignature: http.StatusOK, "aW52YWxpZA==": http.StatusUnauthorized} {
+		httpRequest := httptest.NewRequest(http.MethodPost, "/mockva/snap/v1.0/access-token/b2b", nil)
+		httpRequest.Header.Set(HeaderClientKey, testClientID)
+		httpRequest.Header.Set(HeaderTimestamp, timestamp)
+		httpRequest.Header.Set(HeaderSignature, sig)
+
+		recorder, _ := serve(verifier.Asymmetric, httpRequest)
+		require.Equal(t, expectedStatus, recorder.Code)
+	}
+}
+
+func newTestVerifier(apiClientRepo *mockRepo.MockAPIClientRepository, now time.Time) *SignatureVerifier {
+	verifier := NewSignatureVerifier(service.NewAPIClientService(apiClientRepo, nil), 5*time.Minute, responseWriter.WriteError)
+	verifier.now = func() time.Time { return now }
+	return verifier
+}
+
+// serve runs httpRequest through filter on a web service whose single route echoes the request body.
+func serve(filter restful.FilterFunction, httpRequest *http.Request) (*httptest.ResponseRecorder, string) {
+	var body string
+	ws := new(restful.WebService)
+	ws.Filter(filter)
+	ws.Route(ws.POST("/{path:*}").To(func(request *restful.Request, response *restful.Response) {
+		read, _ := io.ReadAll(request.Request.Body)
+		body = string(read)
+	}))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	recorder := httptest.NewRecorder()
+	container.ServeHTTP(recorder, httpRequest)
+	return recorder, body
+}
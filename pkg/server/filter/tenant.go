@@ -0,0 +1,66 @@
+package filter
+
+import (
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	"github.com/mrth1995/go-mockva/pkg/service"
+	"github.com/mrth1995/go-mockva/pkg/tenant"
+)
+
+const HeaderTenantID = "X-TENANT-ID"
+
+// TenantResolver picks the tenant each request operates on, from the tenant the calling client is
+// bound to or the X-TENANT-ID header, and puts it in the request context for the repositories to
+// scope their queries with. It must run after the filters authenticating the client.
+type TenantResolver struct {
+	apiClientService *service.APIClientService
+	tenantService    *service.TenantService
+	writeError       ErrorWriter
+}
+
+// NewTenantResolver creates a new TenantResolver.
+// Parameters:
+//   - apiClientService: Service providing the tenant the API clients are bound to
+//   - tenantService: Service checking the requested tenants
+//   - writeError: Writes the response of rejected requests
+//
+// Returns:
+//   - *TenantResolver: A resolver whose Filter method is a go-restful filter
+func NewTenantResolver(apiClientService *service.APIClientService, tenantService *service.TenantService, writeError ErrorWriter) *TenantResolver {
+	return &TenantResolver{
+		apiClientService: apiClientService,
+		tenantService:    tenantService,
+		writeError:       writeError,
+	}
+}
+
+// WithErrorWriter returns a copy of the resolver writing errors with writeError.
+func (r *TenantResolver) WithErrorWriter(writeError ErrorWriter) *TenantResolver {
+	return NewTenantResolver(r.apiClientService, r.tenantService, writeError)
+}
+
+// Filter rejects requests for a tenant that does not exist or the client may not operate on.
+// Admin routes manage every tenant and are let through untouched.
+func (r *TenantResolver) Filter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	if admin, _ := request.SelectedRoute().Metadata()[auth.RouteAdminKey].(bool); admin {
+		chain.ProcessFilter(request, response)
+		return
+	}
+	ctx := request.Request.Context()
+	clientTenantID := ""
+	if principal, ok := auth.PrincipalFrom(ctx); ok {
+		client, err := r.apiClientService.FindByID(ctx, principal.ClientID)
+		if err != nil {
+			r.writeError(unknownClient(err), request, response)
+			return
+		}
+		clientTenantID = client.TenantID
+	}
+	tenantID, err := r.tenantService.Resolve(ctx, clientTenantID, request.HeaderParameter(HeaderTenantID))
+	if err != nil {
+		r.writeError(err, request, response)
+		return
+	}
+	request.Request = request.Request.WithContext(tenant.WithID(ctx, tenantID))
+	chain.ProcessFilter(request, response)
+}
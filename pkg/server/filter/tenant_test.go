@@ -0,0 +1,77 @@
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	endpointError "github.com/mrth1995/go-mockva/pkg/errors"
+	mockRepo "github.com/mrth1995/go-mockva/pkg/repository/mock"
+	"github.com/mrth1995/go-mockva/pkg/server/responseWriter"
+	"github.com/mrth1995/go-mockva/pkg/service"
+	"github.com/mrth1995/go-mockva/pkg/tenant"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTenantResolver(t *testing.T) {
+	testCases := []struct {
+		name           string
+		clientTenantID string
+		authenticated  bool
+		header         string
+		expectedStatus int
+		expectedTenant string
+	}{
+		{name: "Anonymous", expectedStatus: http.StatusOK, expectedTenant: tenant.DefaultID},
+		{name: "Anonymous naming a tenant", header: "team-a", expectedStatus: http.StatusOK, expectedTenant: "team-a"},
+		{name: "Unknown tenant", header: "team-x", expectedStatus: http.StatusNotFound},
+		{name: "Bound client", authenticated: true, clientTenantID: "team-a", expectedStatus: http.StatusOK, expectedTenant: "team-a"},
+		{name: "Bound client naming another tenant", authenticated: true, clientTenantID: "team-a", header: "team-b",
+			expectedStatus: http.StatusForbidden},
+		{name: "Unbound client naming a tenant", authenticated: true, header: "team-a", expectedStatus: http.StatusOK, expectedTenant: "team-a"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			apiClientRepo := mockRepo.NewMockAPIClientRepository(ctrl)
+			apiClientRepo.EXPECT().FindByID(gomock.Any(), testClientID).
+				Return(&domain.APIClient{ID: testClientID, TenantID: tc.clientTenantID}, nil).AnyTimes()
+			tenantRepo := mockRepo.NewMockTenantRepository(ctrl)
+			tenantRepo.EXPECT().FindByID(gomock.Any(), "team-a").Return(&domain.Tenant{ID: "team-a"}, nil).AnyTimes()
+			tenantRepo.EXPECT().FindByID(gomock.Any(), "team-x").Return(nil, endpointError.NewTenantNotFound("team-x")).AnyTimes()
+			resolver := NewTenantResolver(service.NewAPIClientService(apiClientRepo, tenantRepo),
+				service.NewTenantService(tenantRepo), responseWriter.WriteError)
+
+			resolvedTenant := ""
+			ws := new(restful.WebService)
+			ws.Filter(func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+				if tc.authenticated {
+					withPrincipal(request, testClientID, nil)
+				}
+				chain.ProcessFilter(request, response)
+			})
+			ws.Filter(resolver.Filter)
+			ws.Route(ws.GET("/accounts").To(func(request *restful.Request, response *restful.Response) {
+				resolvedTenant = tenant.FromContext(request.Request.Context())
+			}))
+			container := restful.NewContainer()
+			container.Add(ws)
+
+			httpRequest := httptest.NewRequest(http.MethodGet, "/accounts", nil)
+			if tc.header != "" {
+				httpRequest.Header.Set(HeaderTenantID, tc.header)
+			}
+			recorder := httptest.NewRecorder()
+			container.ServeHTTP(recorder, httpRequest)
+
+			assertions := require.New(t)
+			assertions.Equal(tc.expectedStatus, recorder.Code)
+			assertions.Equal(tc.expectedTenant, resolvedTenant)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package filter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/ratelimit"
+)
+
+// RequestTimeout bounds the context of each request, so that the queries of a request running for
+// too long are cancelled and the request fails with a timeout error.
+type RequestTimeout struct {
+	timeout time.Duration
+	routes  map[string]time.Duration
+}
+
+// NewRequestTimeout creates a new RequestTimeout.
+// Parameters:
+//   - timeout: How long a request may take, no limit when 0
+//   - routes: How long the requests of a route may take instead, keyed by ratelimit.RouteKey
+//
+// Returns:
+//   - *RequestTimeout: A filter whose Filter method is a go-restful filter
+func NewRequestTimeout(timeout time.Duration, routes map[string]time.Duration) *RequestTimeout {
+	return &RequestTimeout{
+		timeout: timeout,
+		routes:  routes,
+	}
+}
+
+// Filter processes the request with a context bounded by the timeout of its route.
+func (t *RequestTimeout) Filter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	route := request.SelectedRoute()
+	timeout, ok := t.routes[ratelimit.RouteKey(route.Method(), route.Path())]
+	if !ok {
+		timeout = t.timeout
+	}
+	if timeout <= 0 {
+		chain.ProcessFilter(request, response)
+		return
+	}
+	ctx, cancel := context.WithTimeout(request.Request.Context(), timeout)
+	defer cancel()
+	request.Request = request.Request.WithContext(ctx)
+	chain.ProcessFilter(request, response)
+}
+
+// ParseRouteTimeouts parses the timeouts of routes written as comma separated "METHOD path=duration"
+// entries, e.g. "POST /mockva/accountTransactions/transfer=10s,GET /mockva/accountTransactions=1m".
+// Parameters:
+//   - value: The route timeouts, empty for none
+//
+// Returns:
+//   - map[string]time.Duration: The timeout of each route, keyed by ratelimit.RouteKey
+//   - error: If an entry is malformed
+func ParseRouteTimeouts(value string) (map[string]time.Duration, error) {
+	timeouts := map[string]time.Duration{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		route, duration, found := strings.Cut(entry, "=")
+		method, path, hasPath := strings.Cut(strings.TrimSpace(route), " ")
+		if !found || !hasPath || strings.TrimSpace(path) == "" {
+			return nil, fmt.Errorf("route timeout %q is not METHOD path=duration", entry)
+		}
+		timeout, err := time.ParseDuration(strings.TrimSpace(duration))
+		if err != nil || timeout < 0 {
+			return nil, fmt.Errorf("route timeout %q must be a non-negative duration, e.g. 10s", entry)
+		}
+		timeouts[ratelimit.RouteKey(method, strings.TrimSpace(path))] = timeout
+	}
+	return timeouts, nil
+}
@@ -0,0 +1,67 @@
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestTimeout(t *testing.T) {
+	routeTimeouts, err := ParseRouteTimeouts("POST /transfers=1m, GET /accounts/{accountId}=0s")
+	require.NoError(t, err)
+	requestTimeout := NewRequestTimeout(time.Second, routeTimeouts)
+
+	testCases := []struct {
+		name            string
+		method          string
+		path            string
+		expectedTimeout time.Duration
+	}{
+		{name: "Default timeout", method: http.MethodGet, path: "/version", expectedTimeout: time.Second},
+		{name: "Route timeout", method: http.MethodPost, path: "/transfers", expectedTimeout: time.Minute},
+		{name: "Route without timeout", method: http.MethodGet, path: "/accounts/1234"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var deadline time.Time
+			var hasDeadline bool
+			handler := func(request *restful.Request, response *restful.Response) {
+				deadline, hasDeadline = request.Request.Context().Deadline()
+			}
+			ws := new(restful.WebService)
+			ws.Filter(requestTimeout.Filter)
+			ws.Route(ws.GET("/version").To(handler))
+			ws.Route(ws.POST("/transfers").To(handler))
+			ws.Route(ws.GET("/accounts/{accountId}").To(handler))
+			container := restful.NewContainer()
+			container.Add(ws)
+
+			start := time.Now()
+			container.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(tc.method, tc.path, nil))
+
+			assertions := require.New(t)
+			if tc.expectedTimeout == 0 {
+				assertions.False(hasDeadline)
+				return
+			}
+			assertions.True(hasDeadline)
+			assertions.WithinDuration(start.Add(tc.expectedTimeout), deadline, 100*time.Millisecond)
+		})
+	}
+}
+
+func TestParseRouteTimeouts(t *testing.T) {
+	assertions := require.New(t)
+	timeouts, err := ParseRouteTimeouts("")
+	assertions.Nil(err)
+	assertions.Empty(timeouts)
+
+	_, err = ParseRouteTimeouts("POST /transfers")
+	assertions.ErrorContains(err, "is not METHOD path=duration")
+	_, err = ParseRouteTimeouts("POST /transfers=10")
+	assertions.ErrorContains(err, "must be a non-negative duration")
+}
@@ -0,0 +1,39 @@
+package filter
+
+import (
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing starts the server span of each request, continuing the W3C trace context of the caller
+// when it sends a traceparent header. The span is available through the request context to the
+// filters and handlers that follow, so it should be one of the first filters of a web service.
+func Tracing(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	route := request.SelectedRoute()
+	ctx := otel.GetTextMapPropagator().Extract(request.Request.Context(), propagation.HeaderCarrier(request.Request.Header))
+	ctx, span := tracing.Start(ctx, route.Method()+" "+route.Path(),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(route.Method()),
+			semconv.HTTPRoute(route.Path()),
+			semconv.URLPath(request.Request.URL.Path),
+			semconv.ClientAddress(request.Request.RemoteAddr),
+		))
+	defer span.End()
+	request.Request = request.Request.WithContext(ctx)
+
+	chain.ProcessFilter(request, response)
+
+	status := response.StatusCode()
+	span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+	if status >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(status))
+	}
+}
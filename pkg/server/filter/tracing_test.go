@@ -0,0 +1,50 @@
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/tracing"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTracerProvider(noop.NewTracerProvider())
+
+	ws := new(restful.WebService)
+	ws.Filter(Tracing)
+	ws.Route(ws.GET("/accounts/{accountId}").To(func(request *restful.Request, response *restful.Response) {
+		_, span := tracing.Start(request.Request.Context(), "AccountRepository.FindByID")
+		span.End()
+		response.WriteHeader(http.StatusInternalServerError)
+	}))
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	httpRequest := httptest.NewRequest(http.MethodGet, "/accounts/001", nil)
+	httpRequest.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	container.ServeHTTP(httptest.NewRecorder(), httpRequest)
+
+	spans := recorder.Ended()
+	assertions := require.New(t)
+	assertions.Len(spans, 2)
+	child, server := spans[0], spans[1]
+	assertions.Equal("GET /accounts/{accountId}", server.Name())
+	assertions.Equal(trace.SpanKindServer, server.SpanKind())
+	assertions.Equal("4bf92f3577b34da6a3ce929d0e0e4736", server.SpanContext().TraceID().String(), "the trace of the caller continues")
+	assertions.Equal("00f067aa0ba902b7", server.Parent().SpanID().String())
+	assertions.Equal(server.SpanContext().SpanID(), child.Parent().SpanID(), "handler spans are children of the server span")
+	assertions.Equal("Error", server.Status().Code.String())
+}
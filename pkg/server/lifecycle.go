@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// StopFunc stops a component, giving up when ctx is done.
+type StopFunc func(ctx context.Context) error
+
+type component struct {
+	name string
+	stop StopFunc
+}
+
+// Lifecycle stops the components of the server in the reverse order they were registered, so that
+// a component is stopped before the ones it depends on, e.g. the HTTP server before the database.
+type Lifecycle struct {
+	timeout time.Duration
+
+	mu         sync.Mutex
+	components []component
+}
+
+// NewLifecycle creates a Lifecycle with no component.
+// Parameters:
+//   - timeout: How long stopping all the components may take
+//
+// Returns:
+//   - *Lifecycle: A lifecycle to register the components with once they are started
+func NewLifecycle(timeout time.Duration) *Lifecycle {
+	return &Lifecycle{timeout: timeout}
+}
+
+// Register adds a component, stopped before every component registered earlier.
+func (l *Lifecycle) Register(name string, stop StopFunc) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.components = append(l.components, component{name: name, stop: stop})
+}
+
+// Stop stops every registered component in reverse order within the timeout. A component failing
+// to stop does not prevent the next ones from being stopped.
+// Parameters:
+//   - ctx: Context that may end the shutdown before the timeout
+//
+// Returns:
+//   - error: Joins the errors of the components that failed to stop, naming each of them
+func (l *Lifecycle) Stop(ctx context.Context) error {
+	l.mu.Lock()
+	components := l.components
+	l.components = nil
+	l.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, l.timeout)
+	defer cancel()
+	var errs []error
+	for i := len(components) - 1; i >= 0; i-- {
+		component := components[i]
+		logrus.Infof("Stopping %s", component.name)
+		if err := component.stop(ctx); err != nil {
+			logrus.Errorf("unable to stop %s: %v", component.name, err)
+			errs = append(errs, fmt.Errorf("%s: %w", component.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLifecycle_Stop(t *testing.T) {
+	var stopped []string
+	stopFunc := func(name string, err error) StopFunc {
+		return func(ctx context.Context) error {
+			stopped = append(stopped, name)
+			return err
+		}
+	}
+	lifecycle := NewLifecycle(time.Second)
+	lifecycle.Register("tracing", stopFunc("tracing", nil))
+	lifecycle.Register("database", stopFunc("database", errors.New("connection busy")))
+	lifecycle.Register("settlementWorker", stopFunc("settlementWorker", nil))
+	lifecycle.Register("http", stopFunc("http", nil))
+
+	err := lifecycle.Stop(context.Background())
+
+	assertions := require.New(t)
+	assertions.Equal([]string{"http", "settlementWorker", "database", "tracing"}, stopped)
+	assertions.EqualError(err, "database: connection busy")
+
+	stopped = nil
+	assertions.Nil(lifecycle.Stop(context.Background()))
+	assertions.Empty(stopped)
+}
+
+func TestLifecycle_StopTimeout(t *testing.T) {
+	lifecycle := NewLifecycle(10 * time.Millisecond)
+	closed := false
+	lifecycle.Register("database", func(ctx context.Context) error {
+		closed = true
+		return nil
+	})
+	lifecycle.Register("settlementWorker", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	err := lifecycle.Stop(context.Background())
+
+	assertions := require.New(t)
+	assertions.ErrorIs(err, context.DeadlineExceeded)
+	assertions.ErrorContains(err, "settlementWorker")
+	assertions.True(closed)
+}
@@ -1,12 +1,18 @@
 package responseWriter
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"net/http"
+	"strings"
 
 	endpointError "github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/logging"
 
 	"github.com/emicklei/go-restful/v3"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func WriteOK(content any, response *restful.Response) {
@@ -17,6 +23,24 @@ func WriteOK(content any, response *restful.Response) {
 	}
 }
 
+func WriteAccepted(content any, response *restful.Response) {
+	err := response.WriteHeaderAndJson(http.StatusAccepted, content, restful.MIME_JSON)
+	if err != nil {
+		logrus.Error(err)
+		return
+	}
+}
+
+// WriteStatus writes content with the given HTTP status, for APIs that report their outcome
+// in the response body rather than through the error catalog.
+func WriteStatus(httpStatus int, content any, response *restful.Response) {
+	err := response.WriteHeaderAndJson(httpStatus, content, restful.MIME_JSON)
+	if err != nil {
+		logrus.Error(err)
+		return
+	}
+}
+
 func WriteNoContent(response *restful.Response) {
 	response.WriteHeader(http.StatusNoContent)
 }
@@ -25,33 +49,79 @@ func WriteCreated(response *restful.Response) {
 	response.WriteHeader(http.StatusCreated)
 }
 
-func WriteBadRequest(e error, response *restful.Response) {
-	writeError(http.StatusBadRequest, e, response)
+// UseProblemDetails makes every error response use the RFC 7807 application/problem+json format,
+// not only those requested through the Accept header.
+func UseProblemDetails(enabled bool) {
+	problemDetailsByDefault = enabled
 }
 
-func WriteNotFound(e error, response *restful.Response) {
-	writeError(http.StatusNotFound, e, response)
+var problemDetailsByDefault bool
+
+// WriteError writes e with the HTTP status of its error catalog entry.
+// Errors outside the catalog are written as internal server errors.
+func WriteError(e error, request *restful.Request, response *restful.Response) {
+	resp := from(e, request)
+	writeError(resp.Status(), resp, request, response)
 }
 
-func WriteInternalServerError(e error, response *restful.Response) {
-	writeError(http.StatusInternalServerError, e, response)
+// WriteBadRequest writes e as a validation error, mainly for requests that cannot be parsed.
+func WriteBadRequest(e error, request *restful.Request, response *restful.Response) {
+	var endpointErr *endpointError.EndpointError
+	if !errors.As(e, &endpointErr) {
+		e = endpointError.NewValidationError(e.Error())
+	}
+	writeError(http.StatusBadRequest, endpointError.From(e), request, response)
 }
 
-// TODO: wrap error into model
-func writeError(httpStatus int, e error, response *restful.Response) {
-	var resp *endpointError.EndpointError
-	if endpointErr, ok := e.(*endpointError.EndpointError); ok {
-		resp = endpointErr
-	} else {
-		resp = &endpointError.EndpointError{
-			ErrorMessage: e.Error(),
-			ErrorCode:    "96",
-		}
+func WriteNotFound(e error, request *restful.Request, response *restful.Response) {
+	writeError(http.StatusNotFound, from(e, request), request, response)
+}
+
+func WriteInternalServerError(e error, request *restful.Request, response *restful.Response) {
+	writeError(http.StatusInternalServerError, from(e, request), request, response)
+}
+
+// from converts e into an EndpointError and logs the errors outside the catalog, whose message
+// is replaced with a generic one in the response.
+func from(e error, request *restful.Request) *endpointError.EndpointError {
+	resp := endpointError.From(e)
+	if resp.ErrorCode == endpointError.CodeInternal {
+		logging.FromContext(request.Request.Context()).Error(e)
 	}
+	return resp
+}
 
-	err := response.WriteHeaderAndJson(httpStatus, resp, restful.MIME_JSON)
+func writeError(httpStatus int, resp *endpointError.EndpointError, request *restful.Request, response *restful.Response) {
+	var err error
+	if wantsProblemDetails(request) {
+		problem := endpointError.NewProblem(resp, httpStatus, request.Request.URL.Path, traceID(request))
+		err = response.WriteHeaderAndJson(httpStatus, problem, endpointError.ProblemMediaType)
+	} else {
+		err = response.WriteHeaderAndJson(httpStatus, resp, restful.MIME_JSON)
+	}
 	if err != nil {
-		logrus.Error(err)
+		logging.FromContext(request.Request.Context()).Error(err)
 		return
 	}
 }
+
+func wantsProblemDetails(request *restful.Request) bool {
+	return problemDetailsByDefault || strings.Contains(request.HeaderParameter("Accept"), endpointError.ProblemMediaType)
+}
+
+// traceID returns the trace ID of the active span, exported with the spans of the request, then the
+// X-Request-ID of the request, and generates a new one when there is neither.
+func traceID(request *restful.Request) string {
+	if spanContext := trace.SpanContextFromContext(request.Request.Context()); spanContext.TraceID().IsValid() {
+		return spanContext.TraceID().String()
+	}
+	if requestID := logging.RequestID(request.Request.Context()); requestID != "" {
+		return requestID
+	}
+	if requestID := request.HeaderParameter("X-Request-ID"); requestID != "" {
+		return requestID
+	}
+	var id [16]byte
+	_, _ = rand.Read(id[:])
+	return hex.EncodeToString(id[:])
+}
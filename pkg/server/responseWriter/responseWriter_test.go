@@ -0,0 +1,115 @@
+package responseWriter
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/emicklei/go-restful/v3"
+	endpointError "github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestWriteError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedCode   string
+		expectedMsg    string
+	}{
+		{name: "Not found", err: endpointError.NewAccountNotFound("001"), expectedStatus: http.StatusNotFound, expectedCode: endpointError.CodeAccountNotFound,
+			expectedMsg: endpointError.NewAccountNotFound("001").Error()},
+		{name: "Conflict", err: endpointError.NewAccountAlreadyExist("001"), expectedStatus: http.StatusConflict, expectedCode: endpointError.CodeAccountAlreadyExist,
+			expectedMsg: endpointError.NewAccountAlreadyExist("001").Error()},
+		{name: "Unknown", err: errors.New("pq: connection refused"), expectedStatus: http.StatusInternalServerError, expectedCode: endpointError.CodeInternal,
+			expectedMsg: "Internal server error"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			response := restful.NewResponse(recorder)
+			response.SetRequestAccepts(restful.MIME_JSON)
+
+			WriteError(tc.err, newRequest(""), response)
+
+			var body endpointError.EndpointError
+			assertions := require.New(t)
+			assertions.Equal(tc.expectedStatus, recorder.Code)
+			assertions.Nil(json.Unmarshal(recorder.Body.Bytes(), &body))
+			assertions.Equal(tc.expectedCode, body.ErrorCode)
+			assertions.Equal(tc.expectedMsg, body.ErrorMessage)
+		})
+	}
+}
+
+func TestWriteBadRequest_PlainError(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	response := restful.NewResponse(recorder)
+	response.SetRequestAccepts(restful.MIME_JSON)
+
+	WriteBadRequest(errors.New("unexpected EOF"), newRequest(""), response)
+
+	var body endpointError.EndpointError
+	assertions := require.New(t)
+	assertions.Equal(http.StatusBadRequest, recorder.Code)
+	assertions.Nil(json.Unmarshal(recorder.Body.Bytes(), &body))
+	assertions.Equal(endpointError.CodeValidation, body.ErrorCode)
+}
+
+func TestWriteError_ProblemDetails(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	response := restful.NewResponse(recorder)
+	response.SetRequestAccepts(endpointError.ProblemMediaType)
+	request := newRequest(endpointError.ProblemMediaType)
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled})
+	request.Request = request.Request.WithContext(trace.ContextWithSpanContext(request.Request.Context(), spanContext))
+
+	WriteError(endpointError.NewFieldValidationError([]endpointError.FieldError{
+		{Field: "name", Message: "is required"},
+		{Field: "birthDate", Message: "must be a date in YYYY-MM-DD format"},
+	}), request, response)
+
+	var problem endpointError.Problem
+	assertions := require.New(t)
+	assertions.Equal(http.StatusBadRequest, recorder.Code)
+	assertions.Equal(endpointError.ProblemMediaType, recorder.Header().Get("Content-Type"))
+	assertions.Nil(json.Unmarshal(recorder.Body.Bytes(), &problem))
+	assertions.Equal("/mockva/problems/validation-error", problem.Type)
+	assertions.Equal(http.StatusBadRequest, problem.Status)
+	assertions.Equal("/mockva/accounts", problem.Instance)
+	assertions.Equal("4bf92f3577b34da6a3ce929d0e0e4736", problem.TraceID)
+	assertions.Equal(endpointError.CodeValidation, problem.Code)
+	assertions.Len(problem.Errors, 2)
+}
+
+func TestWriteError_ProblemDetails_WithoutSpan(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	response := restful.NewResponse(recorder)
+	response.SetRequestAccepts(endpointError.ProblemMediaType)
+	request := newRequest(endpointError.ProblemMediaType)
+	// Not the trace of any exported span, since no span was started for the request
+	request.Request.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	request.Request.Header.Set("X-Request-ID", "req-123")
+
+	WriteError(endpointError.NewAccountNotFound("001"), request, response)
+
+	var problem endpointError.Problem
+	assertions := require.New(t)
+	assertions.Nil(json.Unmarshal(recorder.Body.Bytes(), &problem))
+	assertions.Equal("req-123", problem.TraceID)
+}
+
+func newRequest(accept string) *restful.Request {
+	httpRequest := httptest.NewRequest(http.MethodPost, "/mockva/accounts", nil)
+	if accept != "" {
+		httpRequest.Header.Set("Accept", accept)
+	}
+	return restful.NewRequest(httpRequest)
+}
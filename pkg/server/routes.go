@@ -2,64 +2,147 @@ package server
 
 import (
 	"net/http"
+	"strings"
 
-	restfulspec "github.com/emicklei/go-restful-openapi/v2"
 	"github.com/emicklei/go-restful/v3"
-	"github.com/go-openapi/spec"
+	"github.com/mrth1995/go-mockva/pkg/apidocs"
+	"github.com/mrth1995/go-mockva/pkg/auth"
 	"github.com/mrth1995/go-mockva/pkg/controller"
+	"github.com/mrth1995/go-mockva/pkg/idgen"
+	"github.com/mrth1995/go-mockva/pkg/ratelimit"
 	"github.com/mrth1995/go-mockva/pkg/repository/postgresql"
+	"github.com/mrth1995/go-mockva/pkg/server/filter"
+	"github.com/mrth1995/go-mockva/pkg/server/responseWriter"
 	"github.com/mrth1995/go-mockva/pkg/service"
 	"github.com/mrth1995/go-mockva/pkg/version"
+	"github.com/sirupsen/logrus"
 )
 
 func (s *Server) initializeRoutes() {
 	ws := new(restful.WebService)
 	ws.Path(contextPath)
+	responseWriter.UseProblemDetails(s.cfg.ErrorFormat == "problem")
 
 	accountRepository := postgresql.NewAccountRepository(s.dbConnection)
 	accountTrxRepository := postgresql.NewAccountTrxRepository(s.dbConnection)
 
 	accountService := service.NewAccountService(accountRepository)
 	txManager := postgresql.NewGormTransactionManager(s.dbConnection)
-	accountTrxService := service.NewAccountTrxService(accountService, accountTrxRepository, txManager)
+	idGenerator, err := idgen.New(s.cfg.IDGenerator, s.cfg.IDGeneratorSeed)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	accountTrxService := service.NewAccountTrxService(accountService, accountTrxRepository, txManager,
+		service.WithAsyncTransfer(s.cfg.AsyncTransfer),
+		service.WithIDGenerator(idGenerator))
+	if s.cfg.AsyncTransfer {
+		s.settlementWorker = service.NewSettlementWorker(accountTrxService, s.cfg.SettlementDelay, s.cfg.SettlementPollInterval)
+	}
+
+	tenantRepository := postgresql.NewTenantRepository(s.dbConnection)
+	tenantService := service.NewTenantService(tenantRepository)
+	apiClientService := service.NewAPIClientService(postgresql.NewAPIClientRepository(s.dbConnection), tenantRepository)
+	tokenService := s.newTokenService(apiClientService)
+	if s.cfg.AdminAPIKey == "" {
+		logrus.Warn("ADMIN_API_KEY is not set, the admin routes managing the API clients are disabled")
+	}
+	ws.Filter(filter.Tracing)
+	ws.Filter(filter.RequestLogger)
+	ws.Filter(filter.Metrics)
+	requestTimeout := s.newRequestTimeout()
+	ws.Filter(requestTimeout.Filter)
+	ws.Filter(filter.NewAdminGuard(s.cfg.AdminAPIKey, responseWriter.WriteError).Filter)
+	if s.cfg.AuthEnabled {
+		authenticator := filter.NewAuthenticator(tokenService, apiClientService, responseWriter.WriteError)
+		ws.Filter(authenticator.Filter)
+	}
+	accessController := filter.NewAccessController(apiClientService, responseWriter.WriteError)
+	ws.Filter(accessController.Filter)
+	rateLimiter := s.newRateLimiter(apiClientService)
+	ws.Filter(rateLimiter.Filter)
+	tenantResolver := filter.NewTenantResolver(apiClientService, tenantService, responseWriter.WriteError)
+	ws.Filter(tenantResolver.Filter)
 
 	accountController := controller.NewAccountController(accountService)
 	accountTrxController := controller.NewAccountTransactionController(accountTrxService)
 	versionController := controller.NewVersionController()
+	apiClientController := controller.NewAPIClientController(apiClientService)
+	tokenController := controller.NewTokenController(tokenService)
+	tenantController := controller.NewTenantController(tenantService)
 
 	s.addRoute(ws, accountController)
 	s.addRoute(ws, accountTrxController)
 	s.addRoute(ws, versionController)
+	s.addRoute(ws, apiClientController)
+	s.addRoute(ws, tokenController)
+	s.addRoute(ws, tenantController)
 	restful.Add(ws)
-	s.addSwaggerDocs()
+	s.initializeSnapRoutes(accountService, accountTrxService, idGenerator, apiClientService, tokenService, requestTimeout, accessController, rateLimiter, tenantResolver)
+	s.addAPIDocs()
 }
 
-func (s *Server) addSwaggerDocs() {
-	webServices := restful.DefaultContainer.RegisteredWebServices()
-	swaggerConfig := restfulspec.Config{
-		WebServices: webServices,
-		APIPath:     contextPath + "/apidocs/api.json",
-		PostBuildSwaggerObjectHandler: func(s *spec.Swagger) {
-			s.Info = &spec.Info{
-				InfoProps: spec.InfoProps{
-					Title:       "mockva",
-					Description: "Mock Virtual Account",
-					Version:     version.Version,
-					Contact: &spec.ContactInfo{
-						ContactInfoProps: spec.ContactInfoProps{
-							Name:  "M Ridwan Taufik H",
-							Email: "mr.taufikhidayat.1995@gmail.com",
-							URL:   "https://www.linkedin.com/in/m-ridwan-taufik-hidayat-775765138/",
-						},
-					},
-				},
-			}
-		},
+func (s *Server) newTokenService(apiClientService *service.APIClientService) *service.TokenService {
+	signingKey, err := auth.LoadSigningKey(s.cfg.TokenSigningKeyFile)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	if s.cfg.TokenSigningKeyFile == "" {
+		logrus.Warn("TOKEN_SIGNING_KEY_FILE is not set, issued tokens will not survive a restart")
+	}
+	tokenIssuer, err := auth.NewTokenIssuer(signingKey, s.cfg.AccessTokenTTL, s.cfg.RefreshTokenTTL)
+	if err != nil {
+		logrus.Fatal(err)
 	}
+	return service.NewTokenService(apiClientService, tokenIssuer)
+}
 
-	http.Handle(contextPath+"/apidocs/", http.StripPrefix(contextPath+"/apidocs/", http.FileServer(http.Dir(s.cfg.SwaggerFilePath))))
+func (s *Server) newRateLimiter(apiClientService *service.APIClientService) *filter.RateLimiter {
+	routeLimits, err := ratelimit.ParseRouteLimits(s.cfg.RouteRateLimits)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	return filter.NewRateLimiter(apiClientService, filter.RateLimits{
+		Global:            s.cfg.RateLimit,
+		Routes:            routeLimits,
+		ClientConcurrency: s.cfg.ClientConcurrencyLimit,
+	}, responseWriter.WriteError)
+}
 
-	service := restfulspec.NewOpenAPIService(swaggerConfig)
+func (s *Server) newRequestTimeout() *filter.RequestTimeout {
+	routeTimeouts, err := filter.ParseRouteTimeouts(s.cfg.RouteTimeouts)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	return filter.NewRequestTimeout(s.cfg.RequestTimeout, routeTimeouts)
+}
+
+// addAPIDocs serves the OpenAPI document of the routes registered so far, and the Swagger UI
+// browsing it, under /apidocs.
+func (s *Server) addAPIDocs() {
+	options := apidocs.Options{
+		Title:       s.cfg.APIDocsTitle,
+		Description: "Mock Virtual Account",
+		Version:     version.Version,
+		Contact: apidocs.Contact{
+			Name:  s.cfg.APIDocsContactName,
+			Email: s.cfg.APIDocsContactEmail,
+			URL:   s.cfg.APIDocsContactURL,
+		},
+	}
+	for _, server := range strings.Split(s.cfg.APIDocsServers, ",") {
+		if server = strings.TrimSpace(server); server != "" {
+			options.Servers = append(options.Servers, server)
+		}
+	}
+	if s.cfg.AuthEnabled {
+		options.TokenURL = contextPath + "/oauth2/token"
+	}
+	options.AdminKeyHeader = filter.HeaderAdminKey
+	document, err := apidocs.Build(restful.DefaultContainer.RegisteredWebServices(), options).Handler()
+	if err != nil {
+		logrus.Fatal(err)
+	}
 
-	restful.Add(service)
+	http.Handle(contextPath+"/apidocs/openapi.json", document)
+	http.Handle(contextPath+"/apidocs/", http.StripPrefix(contextPath+"/apidocs/", apidocs.UIHandler()))
 }
@@ -2,19 +2,31 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/emicklei/go-restful/v3"
 	"github.com/mrth1995/go-mockva/pkg/config"
+	"github.com/mrth1995/go-mockva/pkg/controller"
+	"github.com/mrth1995/go-mockva/pkg/health"
+	"github.com/mrth1995/go-mockva/pkg/logging"
+	"github.com/mrth1995/go-mockva/pkg/metrics"
 	"github.com/mrth1995/go-mockva/pkg/migration"
+	"github.com/mrth1995/go-mockva/pkg/repository/postgresql"
+	"github.com/mrth1995/go-mockva/pkg/service"
+	"github.com/mrth1995/go-mockva/pkg/tracing"
 	"github.com/sirupsen/logrus"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	otelgorm "gorm.io/plugin/opentelemetry/tracing"
 )
 
 const (
-	contextPath = "/mockva"
+	contextPath        = "/mockva"
+	healthCheckTimeout = 2 * time.Second
+	slowQueryThreshold = 200 * time.Millisecond
 )
 
 type Endpoint interface {
@@ -25,13 +37,23 @@ type Server struct {
 	cfg          *config.Config
 	httpServer   *http.Server
 	dbConnection *gorm.DB
+	migration    *migration.Migration
+	health       *health.Checker
+
+	settlementWorker *service.SettlementWorker
+	lifecycle        *Lifecycle
 }
 
 func (s *Server) Initialize(cfg *config.Config) {
 	s.cfg = cfg
+	s.lifecycle = NewLifecycle(cfg.ShutdownTimeout)
+	s.initializeTracing()
 	s.initializeDb()
 	s.migrateDBSchema()
+	s.verifyDBSchema()
 	s.initializeRoutes()
+	s.initializeHealth()
+	http.Handle("/metrics", metrics.Handler())
 }
 
 func (s *Server) Start() error {
@@ -39,36 +61,116 @@ func (s *Server) Start() error {
 		Handler: restful.DefaultContainer,
 		Addr:    fmt.Sprintf("0.0.0.0:%d", s.cfg.Port),
 	}
+	if s.settlementWorker != nil {
+		s.settlementWorker.Start()
+		s.lifecycle.Register("settlement worker", s.settlementWorker.Stop)
+	}
+	s.lifecycle.Register("http server", s.httpServer.Shutdown)
 	logrus.Infof("Server is listening at :%v", s.cfg.Port)
 	return s.httpServer.ListenAndServe()
 }
 
 func (s *Server) Stop(ctx context.Context) error {
 	logrus.Infof("Stopping server")
-	return s.httpServer.Shutdown(ctx)
+	s.health.StartDraining()
+	// Keep serving until the load balancers have seen /readyz fail and stopped routing requests here
+	if s.cfg.ShutdownDrainDelay > 0 {
+		logrus.Infof("Draining for %v", s.cfg.ShutdownDrainDelay)
+		select {
+		case <-time.After(s.cfg.ShutdownDrainDelay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return s.lifecycle.Stop(ctx)
 }
 
 func (s *Server) addRoute(ws *restful.WebService, endpoint Endpoint) {
 	endpoint.RegisterEndpoint(ws)
 }
 
+func (s *Server) initializeTracing() {
+	shutdown, err := tracing.Setup(context.Background(), s.cfg.TracingExporter, s.cfg.TracingSampleRatio)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	s.lifecycle.Register("tracing", shutdown)
+}
+
 func (s *Server) initializeDb() {
-	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d",
-		s.cfg.PostgresHost, s.cfg.PostgresUsername, s.cfg.PostgresPassword, s.cfg.DBName, s.cfg.PostgresPort)
-	connection, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	connection, err := gorm.Open(postgres.Open(s.cfg.PostgresDSN()), &gorm.Config{
+		Logger: logging.NewGormLogger(slowQueryThreshold),
+	})
 	if err != nil {
 		logrus.Fatal(err)
 	}
+	if err = connection.Use(otelgorm.NewPlugin(otelgorm.WithDBName(s.cfg.DBName), otelgorm.WithoutMetrics())); err != nil {
+		logrus.Fatal(err)
+	}
+	if err = connection.Use(&postgresql.Timeouts{Statement: s.cfg.PostgresStatementTimeout, Lock: s.cfg.PostgresLockTimeout}); err != nil {
+		logrus.Fatal(err)
+	}
 	s.dbConnection = connection
+
+	DB, err := connection.DB()
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	DB.SetMaxOpenConns(s.cfg.DBMaxOpenConns)
+	DB.SetMaxIdleConns(s.cfg.DBMaxIdleConns)
+	DB.SetConnMaxLifetime(s.cfg.DBConnMaxLifetime)
+	s.lifecycle.Register("database", func(ctx context.Context) error {
+		return DB.Close()
+	})
+	if err = metrics.RegisterDB(DB, s.cfg.DBName); err != nil {
+		logrus.Fatal(err)
+	}
 }
 
+// migrateDBSchema migrates the database schema to the latest version, unless AUTO_MIGRATE is off
+// and the schema is left to `mockva migrate`.
 func (s *Server) migrateDBSchema() {
 	DB, _ := s.dbConnection.DB()
-	migration, err := migration.NewMigration(DB, s.cfg.DBName, s.cfg.SQLFilePath+"/postgresql")
+	dbMigration, err := migration.NewMigration(DB, s.cfg.DBName)
 	if err != nil {
 		logrus.Fatal(err)
 	}
-	if err = migration.Up(); err != nil {
+	s.migration = dbMigration
+	if !s.cfg.AutoMigrate {
+		if err = dbMigration.Check(context.Background()); err != nil {
+			logrus.Warnf("Automatic migration is disabled: %v", err)
+		}
+		return
+	}
+	if err = dbMigration.Up(); err != nil {
+		logrus.Fatal(err)
+	}
+}
+
+// verifyDBSchema stops the server when the entities do not match the migrated schema, listing the differences.
+func (s *Server) verifyDBSchema() {
+	if err := postgresql.VerifySchema(context.Background(), s.dbConnection); err != nil {
 		logrus.Fatal(err)
 	}
 }
+
+// initializeHealth registers the components /readyz checks and the routes of the probes, outside
+// of the context path so that they are not behind authentication.
+func (s *Server) initializeHealth() {
+	s.health = health.NewChecker(healthCheckTimeout)
+	DB, _ := s.dbConnection.DB()
+	s.health.Register("database", DB.PingContext)
+	s.health.Register("migrations", s.migration.Check)
+	if s.settlementWorker != nil {
+		s.health.Register("settlementWorker", func(ctx context.Context) error {
+			if !s.settlementWorker.Running() {
+				return errors.New("settlement worker is not running")
+			}
+			return nil
+		})
+	}
+
+	ws := new(restful.WebService)
+	s.addRoute(ws, controller.NewHealthController(s.health))
+	restful.Add(ws)
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"github.com/emicklei/go-restful/v3"
+	"github.com/mrth1995/go-mockva/pkg/controller"
+	"github.com/mrth1995/go-mockva/pkg/idgen"
+	"github.com/mrth1995/go-mockva/pkg/repository/postgresql"
+	"github.com/mrth1995/go-mockva/pkg/server/filter"
+	"github.com/mrth1995/go-mockva/pkg/service"
+)
+
+// snapContextPath is the base path of the SNAP BI compatible API. SNAP clients use it as their
+// base URL, e.g. POST /mockva/snap/v1.0/transfer-va/inquiry.
+const snapContextPath = contextPath + "/snap"
+
+// initializeSnapRoutes registers the SNAP BI API on its own web service, backed by the same
+// account and transfer services and the same ID generator as the mockva API. When signature verification is enabled,
+// every request must be signed with the client secret of its X-PARTNER-ID, and SNAP clients can
+// get access tokens by signing token requests with their private key. The access rules of the
+// calling client, the request timeouts, the rate limits and the tenant resolution apply to the SNAP API too.
+func (s *Server) initializeSnapRoutes(accountService service.AccountService, accountTrxService *service.AccountTransactionService, idGenerator idgen.Generator,
+	apiClientService *service.APIClientService, tokenService *service.TokenService, requestTimeout *filter.RequestTimeout,
+	accessController *filter.AccessController, rateLimiter *filter.RateLimiter, tenantResolver *filter.TenantResolver) {
+	ws := new(restful.WebService)
+	ws.Path(snapContextPath)
+	ws.Filter(filter.Tracing)
+	ws.Filter(filter.RequestLogger)
+	ws.Filter(filter.Metrics)
+	ws.Filter(requestTimeout.Filter)
+	if s.cfg.SignatureVerification {
+		signatureVerifier := filter.NewSignatureVerifier(apiClientService, s.cfg.SignatureClockSkew, controller.WriteSnapError)
+		ws.Filter(signatureVerifier.Filter)
+		controller.NewTokenController(tokenService).RegisterSnapEndpoint(ws)
+	}
+	if s.cfg.AuthEnabled {
+		authenticator := filter.NewAuthenticator(tokenService, apiClientService, controller.WriteSnapError)
+		ws.Filter(authenticator.Filter)
+	}
+	ws.Filter(accessController.WithErrorWriter(controller.WriteSnapError).Filter)
+	ws.Filter(rateLimiter.WithErrorWriter(controller.WriteSnapError).Filter)
+	ws.Filter(tenantResolver.WithErrorWriter(controller.WriteSnapError).Filter)
+
+	virtualAccountRepository := postgresql.NewVirtualAccountRepository(s.dbConnection)
+	virtualAccountService := service.NewVirtualAccountService(accountService, accountTrxService, virtualAccountRepository,
+		postgresql.NewGormTransactionManager(s.dbConnection), idGenerator)
+
+	s.addRoute(ws, controller.NewSnapVirtualAccountController(virtualAccountService))
+	restful.Add(ws)
+}
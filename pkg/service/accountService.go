@@ -4,13 +4,13 @@ package service
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/mrth1995/go-mockva/pkg/domain"
+	"github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/logging"
 	"github.com/mrth1995/go-mockva/pkg/model"
 	"github.com/mrth1995/go-mockva/pkg/repository"
-	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
 
@@ -28,7 +28,7 @@ type AccountService interface {
 	// Register creates a new account in the system.
 	// Parameters:
 	//   - ctx: The request context for cancellation and timeouts
-	//   - register: Account registration details including ID, name, address, birth date, and gender
+	//   - register: Account registration details including ID, name, address, birth date, gender and whether the balance may go negative
 	// Returns:
 	//   - *domain.Account: The newly created account
 	//   - error: If account already exists, birth date format is invalid, or database operation fails
@@ -49,10 +49,11 @@ type AccountService interface {
 	// Parameters:
 	//   - ctx: The request context for cancellation and timeouts
 	//   - accountID: The unique account identifier
+	//   - tx: The GORM transaction holding the lock until it ends
 	// Returns:
 	//   - *domain.AccountBalance: The account balance with an active lock
 	//   - error: If the account is not found or a database error occurs
-	FindAndLockAccountBalance(ctx context.Context, accountID string) (*domain.AccountBalance, error)
+	FindAndLockAccountBalance(ctx context.Context, accountID string, tx *gorm.DB) (*domain.AccountBalance, error)
 
 	// UpdateBalance updates the account balance within the provided transaction context.
 	// This method must be called within an active database transaction.
@@ -87,39 +88,52 @@ func NewAccountService(accountRepo repository.AccountRepository) AccountService
 //   - *domain.Account: The account details if found
 //   - error: If the account is not found or a database error occurs
 func (s *AccountServiceImpl) FindByID(ctx context.Context, id string) (*domain.Account, error) {
+	logging.AddAccountIDs(ctx, id)
 	return s.accountRepository.FindByID(ctx, id)
 }
 
 // Register creates a new account in the system.
 // Parameters:
 //   - ctx: The request context for cancellation and timeouts
-//   - register: Account registration details including ID, name, address, birth date, and gender
+//   - register: Account registration details including ID, name, address, birth date, gender and whether the balance may go negative
 //
 // Returns:
 //   - *domain.Account: The newly created account
 //   - error: If account already exists, birth date format is invalid, or database operation fails
 func (s *AccountServiceImpl) Register(ctx context.Context, register *model.AccountRegister) (*domain.Account, error) {
-	existingAccount, notFound := s.accountRepository.FindByID(ctx, register.ID)
-	if existingAccount != nil && notFound == nil {
-		return nil, fmt.Errorf("account %v already exist", register.ID)
+	logging.AddAccountIDs(ctx, register.ID)
+	existingAccount, err := s.accountRepository.FindByID(ctx, register.ID)
+	if err != nil && !errors.Is(err, errors.ErrAccountNotFound) {
+		return nil, err
+	}
+	if existingAccount != nil {
+		return nil, errors.NewAccountAlreadyExist(register.ID)
 	}
 
 	birthDate, err := time.Parse(time.DateOnly, register.BirthDate)
 	if err != nil {
-		logrus.Errorf("Invalid date format %v", register.BirthDate)
-		return nil, err
+		logging.FromContext(ctx).Errorf("Invalid date format %v", register.BirthDate)
+		return nil, errors.NewValidationErrorf("invalid birth date format %v", register.BirthDate)
 	}
 
 	newAccount := &domain.Account{
 		ID:        register.ID,
+		AccountID: register.ID,
 		Name:      register.Name,
 		Address:   register.Address,
 		BirthDate: birthDate,
 		Gender:    register.Gender,
 	}
-	accountAlreadyExist := s.accountRepository.Save(ctx, newAccount)
-	if accountAlreadyExist != nil {
-		return nil, accountAlreadyExist
+	// Accounts open with an empty balance, funded by deposits and transfers.
+	openingBalance := &domain.AccountBalance{
+		ID:                   register.ID,
+		AccountID:            register.ID,
+		Balance:              0,
+		AllowNegativeBalance: register.AllowNegativeBalance,
+	}
+	// Save reports an account registered concurrently, after FindByID, as already existing.
+	if err = s.accountRepository.Save(ctx, newAccount, openingBalance); err != nil {
+		return nil, err
 	}
 	return newAccount, nil
 }
@@ -128,12 +142,13 @@ func (s *AccountServiceImpl) Register(ctx context.Context, register *model.Accou
 // Parameters:
 //   - ctx: The request context for cancellation and timeouts
 //   - id: The unique account identifier
-//   - edit: Account fields to update (name, address, gender, birth date) - nil values are ignored
+//   - edit: Account fields to update (name, address, gender, birth date, whether the balance may go negative) - nil values are ignored
 //
 // Returns:
 //   - *domain.Account: The updated account
 //   - error: If account is not found, birth date format is invalid, or database operation fails
 func (s *AccountServiceImpl) Edit(ctx context.Context, id string, edit *model.AccountEdit) (*domain.Account, error) {
+	logging.AddAccountIDs(ctx, id)
 	existingAccount, err := s.accountRepository.FindByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -150,8 +165,8 @@ func (s *AccountServiceImpl) Edit(ctx context.Context, id string, edit *model.Ac
 	if edit.BirthDate != nil && *edit.BirthDate != "" {
 		birthDate, err := time.Parse(time.DateOnly, *edit.BirthDate)
 		if err != nil {
-			logrus.Errorf("Invalid date format %v", *edit.BirthDate)
-			return nil, err
+			logging.FromContext(ctx).Errorf("Invalid date format %v", *edit.BirthDate)
+			return nil, errors.NewValidationErrorf("invalid birth date format %v", *edit.BirthDate)
 		}
 		existingAccount.BirthDate = birthDate
 	}
@@ -159,6 +174,11 @@ func (s *AccountServiceImpl) Edit(ctx context.Context, id string, edit *model.Ac
 	if err != nil {
 		return nil, err
 	}
+	if edit.AllowNegativeBalance != nil {
+		if err = s.accountRepository.UpdateAllowNegativeBalance(ctx, id, *edit.AllowNegativeBalance); err != nil {
+			return nil, err
+		}
+	}
 	return existingAccount, nil
 }
 
@@ -167,12 +187,14 @@ func (s *AccountServiceImpl) Edit(ctx context.Context, id string, edit *model.Ac
 // Parameters:
 //   - ctx: The request context for cancellation and timeouts
 //   - accountID: The unique account identifier
+//   - tx: The GORM transaction holding the lock until it ends
 //
 // Returns:
 //   - *domain.AccountBalance: The account balance with an active lock
 //   - error: If the account is not found or a database error occurs
-func (s *AccountServiceImpl) FindAndLockAccountBalance(ctx context.Context, accountID string) (*domain.AccountBalance, error) {
-	return s.accountRepository.FindAndLockAccountBalance(ctx, accountID)
+func (s *AccountServiceImpl) FindAndLockAccountBalance(ctx context.Context, accountID string, tx *gorm.DB) (*domain.AccountBalance, error) {
+	logging.AddAccountIDs(ctx, accountID)
+	return s.accountRepository.FindAndLockAccountBalance(ctx, accountID, tx)
 }
 
 // UpdateBalance updates the account balance within the provided transaction context.
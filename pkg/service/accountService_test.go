@@ -12,6 +12,7 @@ import (
 	"github.com/mrth1995/go-mockva/pkg/utils"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 )
 
 const (
@@ -31,7 +32,7 @@ func TestAccountServiceImpl_Register(t *testing.T) {
 		Address:              "Jl sadarmanah",
 		BirthDate:            birthDate,
 		Gender:               false,
-		AllowNegativeBalance: false,
+		AllowNegativeBalance: true,
 	}
 
 	repository := accountMock.NewMockAccountRepository(ctrl)
@@ -41,12 +42,14 @@ func TestAccountServiceImpl_Register(t *testing.T) {
 		FindByID(gomock.Any(), "100").
 		Return(nil, errors.NewAccountNotFound("100"))
 
-	// Mock Save to capture the saved account
+	// Mock Save to capture the saved account and its opening balance
 	var capturedAccount *domain.Account
+	var capturedBalance *domain.AccountBalance
 	repository.EXPECT().
-		Save(gomock.Any(), gomock.Any()).
-		DoAndReturn(func(ctx context.Context, acc *domain.Account) error {
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, acc *domain.Account, balance *domain.AccountBalance) error {
 			capturedAccount = acc
+			capturedBalance = balance
 			return nil
 		})
 
@@ -57,10 +60,13 @@ func TestAccountServiceImpl_Register(t *testing.T) {
 	assertions.Nil(accountAlreadyExist, "Should not error")
 	assertions.NotNilf(account, "Created account should not be empty")
 	assertions.Equalf(capturedAccount.ID, account.ID, "Account ID should equals")
+	assertions.Equalf(account.ID, capturedAccount.AccountID, "Account number should be the account ID")
 	assertions.Equalf(capturedAccount.Address, account.Address, "Address should equals")
 	assertions.Equalf(capturedAccount.BirthDate, account.BirthDate, "BirthDate should equals")
 	assertions.Equalf(capturedAccount.Gender, account.Gender, "Gender should equals")
 	assertions.Equalf(capturedAccount.Name, account.Name, "Name should equals")
+	assertions.Equal(&domain.AccountBalance{ID: "100", AccountID: "100", AllowNegativeBalance: true}, capturedBalance,
+		"Account should open with an empty balance allowed to go negative as registered")
 }
 
 func TestAccountServiceImpl_Register_AccountAlreadyExist(t *testing.T) {
@@ -92,6 +98,125 @@ func TestAccountServiceImpl_Register_AccountAlreadyExist(t *testing.T) {
 	assertions := require.New(t)
 	assertions.Nil(newAccount)
 	assertions.NotNilf(alreadyExist, "Error already exist should not be nil")
+	assertions.ErrorIs(alreadyExist, errors.ErrAccountAlreadyExist)
+}
+
+func TestAccountServiceImpl_Register_SaveConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	accountRegister := &model.AccountRegister{
+		ID:        "111",
+		Name:      "Ridwan",
+		BirthDate: "1996-03-11",
+	}
+
+	repository := accountMock.NewMockAccountRepository(ctrl)
+	repository.EXPECT().
+		FindByID(gomock.Any(), accountRegister.ID).
+		Return(nil, errors.NewAccountNotFound(accountRegister.ID))
+	// Registered concurrently between FindByID and Save
+	repository.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errors.NewAccountAlreadyExist(accountRegister.ID))
+
+	accountService := &AccountServiceImpl{accountRepository: repository}
+	newAccount, err := accountService.Register(ctx, accountRegister)
+	assertions := require.New(t)
+	assertions.Nil(newAccount)
+	assertions.ErrorIs(err, errors.ErrAccountAlreadyExist)
+}
+
+// A registered account can be funded and debited through the API right away.
+func TestAccountServiceImpl_Register_DepositWithdraw(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	// The balances stored by the repository, the settlement accounts seeded with the tenant
+	balances := map[string]*domain.AccountBalance{
+		domain.CashInSettlementAccountID:  {ID: domain.CashInSettlementAccountID, AccountID: domain.CashInSettlementAccountID, AllowNegativeBalance: true},
+		domain.CashOutSettlementAccountID: {ID: domain.CashOutSettlementAccountID, AccountID: domain.CashOutSettlementAccountID, AllowNegativeBalance: true},
+	}
+	repository := accountMock.NewMockAccountRepository(ctrl)
+	repository.EXPECT().
+		FindByID(gomock.Any(), "100").
+		Return(nil, errors.NewAccountNotFound("100"))
+	repository.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, acc *domain.Account, balance *domain.AccountBalance) error {
+			balances[balance.ID] = balance
+			return nil
+		})
+	repository.EXPECT().
+		FindAndLockAccountBalance(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, tx *gorm.DB) (*domain.AccountBalance, error) {
+			balance, ok := balances[id]
+			if !ok {
+				return nil, errors.NewAccountNotFound(id)
+			}
+			stored := *balance
+			return &stored, nil
+		}).
+		AnyTimes()
+	repository.EXPECT().
+		UpdateBalance(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, balance *domain.AccountBalance, tx *gorm.DB) (*domain.AccountBalance, error) {
+			balances[balance.ID] = balance
+			return balance, nil
+		}).
+		AnyTimes()
+	accountTrxRepo := accountMock.NewMockAccountTransactionRepository(ctrl)
+	accountTrxRepo.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	txManager := accountMock.NewMockDBTransactionManager(ctrl)
+	txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
+		return fc(nil)
+	}).Times(3)
+
+	accountService := NewAccountService(repository)
+	accountTrxService := NewAccountTrxService(accountService, accountTrxRepo, txManager)
+
+	assertions := require.New(t)
+	_, err := accountService.Register(ctx, &model.AccountRegister{ID: "100", Name: "Siska", BirthDate: "1996-03-11"})
+	assertions.Nil(err)
+	assertions.Equal(float64(0), balances["100"].Balance)
+
+	_, err = accountTrxService.Deposit(ctx, &model.AccountDeposit{AccountID: "100", Amount: 100_000, Channel: "TELLER"})
+	assertions.Nil(err)
+	_, err = accountTrxService.Withdraw(ctx, &model.AccountWithdrawal{AccountID: "100", Amount: 40_000, Channel: "ATM"})
+	assertions.Nil(err)
+	assertions.Equal(float64(60_000), balances["100"].Balance)
+
+	_, err = accountTrxService.Withdraw(ctx, &model.AccountWithdrawal{AccountID: "100", Amount: 100_000, Channel: "ATM"})
+	assertions.ErrorIs(err, errors.ErrInsufficientFunds, "the opening balance does not allow a negative balance")
+	assertions.Equal(float64(60_000), balances["100"].Balance)
+}
+
+func TestAccountServiceImpl_Register_FindByIDFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	accountRegister := &model.AccountRegister{
+		ID:        "111",
+		Name:      "Ridwan",
+		BirthDate: "1996-03-11",
+	}
+
+	repository := accountMock.NewMockAccountRepository(ctrl)
+	repository.EXPECT().
+		FindByID(gomock.Any(), accountRegister.ID).
+		Return(nil, errors.NewTimeout("statement timed out"))
+
+	accountService := &AccountServiceImpl{accountRepository: repository}
+	newAccount, err := accountService.Register(ctx, accountRegister)
+	assertions := require.New(t)
+	assertions.Nil(newAccount)
+	assertions.ErrorIs(err, errors.ErrTimeout)
 }
 
 func TestAccountServiceImpl_Edit(t *testing.T) {
@@ -136,6 +261,32 @@ func TestAccountServiceImpl_Edit(t *testing.T) {
 	assertions.Equal(editedBirthdate.String(), capturedAccount.BirthDate.String())
 }
 
+func TestAccountServiceImpl_Edit_AllowNegativeBalance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	repository := accountMock.NewMockAccountRepository(ctrl)
+	repository.EXPECT().
+		FindByID(gomock.Any(), accountID).
+		Return(&domain.Account{ID: accountID, AccountID: accountID}, nil)
+	repository.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		Return(nil, nil)
+	repository.EXPECT().
+		UpdateAllowNegativeBalance(gomock.Any(), accountID, true).
+		Return(nil)
+
+	edit := getEditAccount()
+	edit.AllowNegativeBalance = utils.ToBooleanPointer(true)
+	service := &AccountServiceImpl{accountRepository: repository}
+	account, err := service.Edit(ctx, accountID, edit)
+	assertions := require.New(t)
+	assertions.Nil(err)
+	assertions.NotNil(account)
+}
+
 func TestAccountServiceImpl_Edit_AccountNotFound(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -157,6 +308,27 @@ func TestAccountServiceImpl_Edit_AccountNotFound(t *testing.T) {
 	assertions.NotNil(err, "Account not found")
 }
 
+func TestAccountServiceImpl_Edit_UpdateFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	repository := accountMock.NewMockAccountRepository(ctrl)
+	repository.EXPECT().
+		FindByID(gomock.Any(), accountID).
+		Return(&domain.Account{ID: accountID, AccountID: accountID}, nil)
+	repository.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		Return(nil, errors.NewConflict("concurrent update, retry the request"))
+
+	service := &AccountServiceImpl{accountRepository: repository}
+	account, err := service.Edit(ctx, accountID, getEditAccount())
+	assertions := require.New(t)
+	assertions.Nil(account)
+	assertions.ErrorIs(err, errors.ErrConflict)
+}
+
 func getEditAccount() *model.AccountEdit {
 	return &model.AccountEdit{
 		Name:      utils.ToStringPointer("Ridwan"),
@@ -2,16 +2,31 @@ package service
 
 import (
 	"context"
-	"errors"
-	"strconv"
+	"net/http"
 	"time"
 
+	"github.com/mrth1995/go-mockva/pkg/auth"
 	"github.com/mrth1995/go-mockva/pkg/domain"
+	"github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/idgen"
+	"github.com/mrth1995/go-mockva/pkg/metrics"
 	"github.com/mrth1995/go-mockva/pkg/model"
 	"github.com/mrth1995/go-mockva/pkg/repository"
+	"github.com/mrth1995/go-mockva/pkg/tenant"
+	"github.com/mrth1995/go-mockva/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm"
 )
 
+const (
+	maxRemarkLength            = 255
+	maxExternalReferenceLength = 64
+	maxPurposeCodeLength       = 8
+	defaultPageSize            = 20
+	maxPageSize                = 100
+)
+
 // AccountTransactionService handles business logic for account transactions.
 // It coordinates between account operations and transaction persistence,
 // ensuring atomic fund transfers between accounts.
@@ -19,6 +34,26 @@ type AccountTransactionService struct {
 	accountService       AccountService
 	accountTrxRepository repository.AccountTransactionRepository
 	txManager            repository.DBTransactionManager
+	idGenerator          idgen.Generator
+	asyncTransfer        bool
+}
+
+// AccountTrxServiceOption configures optional behaviour of AccountTransactionService.
+type AccountTrxServiceOption func(s *AccountTransactionService)
+
+// WithAsyncTransfer makes Transfer accept transfers as PENDING instead of settling them
+// immediately. Pending transfers are settled later by SettlePending, usually driven by a SettlementWorker.
+func WithAsyncTransfer(enabled bool) AccountTrxServiceOption {
+	return func(s *AccountTransactionService) {
+		s.asyncTransfer = enabled
+	}
+}
+
+// WithIDGenerator sets the generator used for transaction IDs. A random ULID generator is used by default.
+func WithIDGenerator(generator idgen.Generator) AccountTrxServiceOption {
+	return func(s *AccountTransactionService) {
+		s.idGenerator = generator
+	}
 }
 
 // NewAccountTrxService creates a new instance of AccountTransactionService.
@@ -26,15 +61,145 @@ type AccountTransactionService struct {
 //   - accountService: Service for account operations and balance management
 //   - accountTrxRepo: Repository for persisting transaction records
 //   - txManager: Manager for coordinating database transactions
+//   - opts: Optional behaviour such as asynchronous settlement or the transaction ID generator
 //
 // Returns:
 //   - *AccountTransactionService: A new service instance
-func NewAccountTrxService(accountService AccountService, accountTrxRepo repository.AccountTransactionRepository, txManager repository.DBTransactionManager) *AccountTransactionService {
-	return &AccountTransactionService{
+func NewAccountTrxService(accountService AccountService, accountTrxRepo repository.AccountTransactionRepository, txManager repository.DBTransactionManager, opts ...AccountTrxServiceOption) *AccountTransactionService {
+	s := &AccountTransactionService{
 		accountService:       accountService,
 		accountTrxRepository: accountTrxRepo,
 		txManager:            txManager,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.idGenerator == nil {
+		s.idGenerator, _ = idgen.New(idgen.KindULID, 0)
+	}
+	return s
+}
+
+// FindByID retrieves a transaction by its unique identifier so clients can poll its status.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - id: The unique transaction identifier
+//
+// Returns:
+//   - *domain.AccountTransaction: The transaction if found
+//   - error: If the transaction is not found or a database error occurs
+func (s *AccountTransactionService) FindByID(ctx context.Context, id string) (*domain.AccountTransaction, error) {
+	return s.accountTrxRepository.FindByID(ctx, id)
+}
+
+// FindInfoByID retrieves a transaction by its unique identifier together with the names of its accounts.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - id: The unique transaction identifier
+//
+// Returns:
+//   - *model.AccountTransactionInfo: The transaction details if found
+//   - error: If the transaction is not found or a database error occurs
+func (s *AccountTransactionService) FindInfoByID(ctx context.Context, id string) (*model.AccountTransactionInfo, error) {
+	accountTrx, err := s.accountTrxRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.ToInfo(ctx, accountTrx)
+}
+
+// ToInfo converts a transaction into its API representation, resolving the source and
+// destination account names.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - accountTrx: The transaction to convert
+//
+// Returns:
+//   - *model.AccountTransactionInfo: The transaction details
+//   - error: If one of the accounts cannot be retrieved
+func (s *AccountTransactionService) ToInfo(ctx context.Context, accountTrx *domain.AccountTransaction) (*model.AccountTransactionInfo, error) {
+	accountSrc, err := s.accountService.FindByID(ctx, accountTrx.AccountSrcId)
+	if err != nil {
+		return nil, err
+	}
+	accountDst, err := s.accountService.FindByID(ctx, accountTrx.AccountDstId)
+	if err != nil {
+		return nil, err
+	}
+	return &model.AccountTransactionInfo{
+		ID:                   accountTrx.ID,
+		Amount:               accountTrx.Amount,
+		Type:                 string(accountTrx.Type),
+		Status:               string(accountTrx.Status),
+		StatusRemark:         accountTrx.StatusReason,
+		Channel:              accountTrx.Channel,
+		Reference:            accountTrx.Reference,
+		Remark:               accountTrx.Remark,
+		ExternalReference:    accountTrx.ExternalReference,
+		PurposeCode:          accountTrx.PurposeCode,
+		Metadata:             accountTrx.Metadata,
+		ClientID:             accountTrx.ClientID,
+		AccountSrcID:         accountTrx.AccountSrcId,
+		AccountSrcName:       accountSrc.Name,
+		AccountDstID:         accountTrx.AccountDstId,
+		AccountDstName:       accountDst.Name,
+		TransactionTimestamp: accountTrx.TransactionTimestamp,
+		SettledAt:            accountTrx.SettledAt,
+	}, nil
+}
+
+// Search retrieves the transaction history matching the given criteria, newest first.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - search: Search criteria such as account, external reference, purpose code, metadata and pagination
+//
+// Returns:
+//   - *model.AccountTransactionPage: The requested page of transactions with their account names resolved
+//   - error: If the criteria are invalid or a database error occurs
+func (s *AccountTransactionService) Search(ctx context.Context, search *model.AccountTransactionSearch) (*model.AccountTransactionPage, error) {
+	page := search.Page
+	if page <= 0 {
+		page = 1
+	}
+	size := search.Size
+	if size <= 0 {
+		size = defaultPageSize
+	}
+	if size > maxPageSize {
+		return nil, errors.NewValidationErrorf("page size cannot be greater than %d", maxPageSize)
+	}
+	filter := &repository.AccountTransactionFilter{
+		AccountID:         search.AccountID,
+		ExternalReference: search.ExternalReference,
+		PurposeCode:       search.PurposeCode,
+		Remark:            search.Remark,
+		Status:            domain.TransactionStatus(search.Status),
+		Type:              domain.TransactionType(search.Type),
+		ClientID:          search.ClientID,
+		Metadata:          search.Metadata,
+		From:              search.From,
+		To:                search.To,
+		Offset:            (page - 1) * size,
+		Limit:             size,
+	}
+	trxs, total, err := s.accountTrxRepository.Search(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]model.AccountTransactionInfo, 0, len(trxs))
+	for i := range trxs {
+		trxInfo, err := s.ToInfo(ctx, &trxs[i])
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, *trxInfo)
+	}
+	return &model.AccountTransactionPage{
+		Items: items,
+		Page:  page,
+		Size:  size,
+		Total: total,
+	}, nil
 }
 
 // Transfer moves funds between two accounts atomically using a database transaction.
@@ -42,71 +207,403 @@ func NewAccountTrxService(accountService AccountService, accountTrxRepo reposito
 //   - Source and destination accounts must not be empty
 //   - Transfer amount must be positive
 //   - Source and destination accounts must be different
+//   - Neither account may be a settlement account, which only deposits and withdrawals move funds through
+//   - Remark, external reference and purpose code must not exceed their maximum length
 //   - Source account must have sufficient balance (unless negative balance is allowed)
 //
 // The transfer is executed within a database transaction with pessimistic locking
-// to prevent concurrent modification issues.
+// to prevent concurrent modification issues. When asynchronous transfer is enabled,
+// the transfer is only recorded as PENDING and the balances are moved by SettlePending.
 //
 // Parameters:
 //   - ctx: The request context for cancellation and timeouts
-//   - accountFundTransfer: Transfer details including source account, destination account, and amount
+//   - accountFundTransfer: Transfer details including source account, destination account, amount and reconciliation references
 //
 // Returns:
-//   - *domain.AccountTransaction: The completed transaction record with updated balances
+//   - *domain.AccountTransaction: The completed (or pending) transaction record with updated balances
 //   - error: If validation fails, accounts not found, insufficient balance, or database operation fails
-func (s *AccountTransactionService) Transfer(ctx context.Context, accountFundTransfer *model.AccountFundTransfer) (*domain.AccountTransaction, error) {
+func (s *AccountTransactionService) Transfer(ctx context.Context, accountFundTransfer *model.AccountFundTransfer) (_ *domain.AccountTransaction, err error) {
+	ctx, span := tracing.Start(ctx, "AccountTransactionService.Transfer", trace.WithAttributes(
+		attribute.String("mockva.account_src_id", accountFundTransfer.AccountSrcID),
+		attribute.String("mockva.account_dst_id", accountFundTransfer.AccountDstID),
+		attribute.Float64("mockva.amount", accountFundTransfer.Amount),
+		attribute.Bool("mockva.async", s.asyncTransfer),
+	))
+	defer func() { tracing.End(span, err) }()
+
 	if accountFundTransfer.AccountSrcID == "" {
-		return nil, errors.New("account src cannot be empty")
+		return nil, errors.NewValidationError("account src cannot be empty")
 	}
 	if accountFundTransfer.AccountDstID == "" {
-		return nil, errors.New("account dst cannot be empty")
+		return nil, errors.NewValidationError("account dst cannot be empty")
 	}
 	if accountFundTransfer.Amount <= 0 {
-		return nil, errors.New("invalid amount")
+		return nil, errors.NewValidationError("invalid amount")
 	}
 	if accountFundTransfer.AccountDstID == accountFundTransfer.AccountSrcID {
-		return nil, errors.New("cannot transfer with same account")
+		return nil, errors.NewValidationError("cannot transfer with same account")
+	}
+	if isSettlementAccount(accountFundTransfer.AccountSrcID) || isSettlementAccount(accountFundTransfer.AccountDstID) {
+		return nil, errors.NewValidationError("cannot use settlement account")
+	}
+	if len(accountFundTransfer.Remark) > maxRemarkLength {
+		return nil, errors.NewValidationErrorf("remark cannot be longer than %d characters", maxRemarkLength)
+	}
+	if len(accountFundTransfer.ExternalReference) > maxExternalReferenceLength {
+		return nil, errors.NewValidationErrorf("external reference cannot be longer than %d characters", maxExternalReferenceLength)
+	}
+	if len(accountFundTransfer.PurposeCode) > maxPurposeCodeLength {
+		return nil, errors.NewValidationErrorf("purpose code cannot be longer than %d characters", maxPurposeCodeLength)
+	}
+
+	accountTrx := &domain.AccountTransaction{
+		Amount:            accountFundTransfer.Amount,
+		Type:              domain.TransactionTypeTransfer,
+		Remark:            accountFundTransfer.Remark,
+		ExternalReference: accountFundTransfer.ExternalReference,
+		PurposeCode:       accountFundTransfer.PurposeCode,
+		Metadata:          accountFundTransfer.Metadata,
+		AccountSrcId:      accountFundTransfer.AccountSrcID,
+		AccountDstId:      accountFundTransfer.AccountDstID,
+	}
+	if s.asyncTransfer {
+		return s.accept(ctx, accountTrx)
 	}
+	return s.post(ctx, accountTrx, true)
+}
 
+// Deposit credits an account with funds coming from an external channel (cash-in).
+// The funds are debited from the cash-in settlement account, which is never checked
+// for sufficient balance.
+//
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - deposit: Deposit details including destination account, amount, channel and reference
+//
+// Returns:
+//   - *domain.AccountTransaction: The completed transaction record
+//   - error: If validation fails, the account is not found, or database operation fails
+func (s *AccountTransactionService) Deposit(ctx context.Context, deposit *model.AccountDeposit) (*domain.AccountTransaction, error) {
+	accountTrx, err := newDepositTransaction(deposit)
+	if err != nil {
+		return nil, err
+	}
+	return s.post(ctx, accountTrx, false)
+}
+
+// DepositInTx credits an account like Deposit, within a database transaction opened by the caller,
+// so that the deposit is committed or rolled back together with the caller's own changes.
+//
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - deposit: Deposit details including destination account, amount, channel and reference
+//   - tx: The GORM transaction of the caller
+//
+// Returns:
+//   - *domain.AccountTransaction: The transaction record, persisted once tx commits
+//   - error: If validation fails, the account is not found, or database operation fails
+func (s *AccountTransactionService) DepositInTx(ctx context.Context, deposit *model.AccountDeposit, tx *gorm.DB) (*domain.AccountTransaction, error) {
+	accountTrx, err := newDepositTransaction(deposit)
+	if err != nil {
+		return nil, err
+	}
+	err = s.postInTx(ctx, tx, accountTrx, false)
+	observeTransfer(accountTrx, err)
+	if err != nil {
+		return nil, err
+	}
+	return accountTrx, nil
+}
+
+func newDepositTransaction(deposit *model.AccountDeposit) (*domain.AccountTransaction, error) {
+	if err := validateCashTransaction(deposit.AccountID, deposit.Amount, deposit.Channel); err != nil {
+		return nil, err
+	}
+	return &domain.AccountTransaction{
+		Amount:       deposit.Amount,
+		Type:         domain.TransactionTypeDeposit,
+		Channel:      deposit.Channel,
+		Reference:    deposit.Reference,
+		AccountSrcId: domain.CashInSettlementAccountID,
+		AccountDstId: deposit.AccountID,
+	}, nil
+}
+
+// Withdraw debits an account and moves the funds out to an external channel (cash-out).
+// The funds are credited to the cash-out settlement account. The account must have
+// sufficient balance unless negative balance is allowed.
+//
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - withdrawal: Withdrawal details including source account, amount, channel and reference
+//
+// Returns:
+//   - *domain.AccountTransaction: The completed transaction record
+//   - error: If validation fails, the account is not found, insufficient balance, or database operation fails
+func (s *AccountTransactionService) Withdraw(ctx context.Context, withdrawal *model.AccountWithdrawal) (*domain.AccountTransaction, error) {
+	if err := validateCashTransaction(withdrawal.AccountID, withdrawal.Amount, withdrawal.Channel); err != nil {
+		return nil, err
+	}
+	accountTrx := &domain.AccountTransaction{
+		Amount:       withdrawal.Amount,
+		Type:         domain.TransactionTypeWithdrawal,
+		Channel:      withdrawal.Channel,
+		Reference:    withdrawal.Reference,
+		AccountSrcId: withdrawal.AccountID,
+		AccountDstId: domain.CashOutSettlementAccountID,
+	}
+	return s.post(ctx, accountTrx, true)
+}
+
+// Settle completes a PENDING transaction by moving the funds between its accounts.
+// If the source account has insufficient balance the transaction is marked FAILED instead.
+// Transactions that are no longer PENDING are left untouched.
+//
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - id: The unique transaction identifier
+//
+// Returns:
+//   - *domain.AccountTransaction: The transaction with its final status
+//   - error: If the transaction or its accounts are not found, or database operation fails
+func (s *AccountTransactionService) Settle(ctx context.Context, id string) (*domain.AccountTransaction, error) {
 	var accountTrx *domain.AccountTransaction
-	err := s.txManager.Transaction(func(tx *gorm.DB) error {
-		accountSrc, err := s.accountService.FindAndLockAccountBalance(ctx, accountFundTransfer.AccountSrcID)
+	settled := false
+	err := s.txManager.Transaction(ctx, func(tx *gorm.DB) error {
+		var err error
+		accountTrx, err = s.accountTrxRepository.FindAndLockByID(ctx, id, tx)
 		if err != nil {
 			return err
 		}
-		accountDst, err := s.accountService.FindAndLockAccountBalance(ctx, accountFundTransfer.AccountDstID)
+		if accountTrx.Status != domain.TransactionStatusPending {
+			return nil
+		}
+		accountSrc, accountDst, err := s.lockBalances(ctx, tx, accountTrx.AccountSrcId, accountTrx.AccountDstId)
 		if err != nil {
 			return err
 		}
-		if accountSrc.Balance-accountFundTransfer.Amount < 0 && !accountSrc.AllowNegativeBalance {
-			return errors.New("insufficient amount")
+		settled = true
+		if !hasSufficientBalance(accountSrc, accountTrx.Amount) {
+			markSettled(accountTrx, domain.TransactionStatusFailed, "insufficient amount")
+			return s.accountTrxRepository.Update(ctx, accountTrx, tx)
 		}
-		trxID := accountSrc.ID + ":" + accountDst.ID + ":" + strconv.Itoa(time.Now().Nanosecond())
-		accountTrx = &domain.AccountTransaction{
-			ID:                   trxID,
-			TransactionTimestamp: time.Now(),
-			Amount:               accountFundTransfer.Amount,
-			AccountSrc:           accountSrc,
-			AccountDst:           accountDst,
+		markSettled(accountTrx, domain.TransactionStatusSuccess, "")
+		if err := s.accountTrxRepository.Update(ctx, accountTrx, tx); err != nil {
+			return err
 		}
-		accountSrc.Balance = accountSrc.Balance - accountFundTransfer.Amount
-		accountDst.Balance = accountDst.Balance + accountFundTransfer.Amount
+		return s.moveBalance(ctx, tx, accountSrc, accountDst, accountTrx.Amount)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if settled {
+		observeTransfer(accountTrx, nil)
+	}
+	return accountTrx, nil
+}
 
-		if err := s.accountTrxRepository.Save(accountTrx, tx); err != nil {
-			return err
+// SettlePending settles every PENDING transaction that has been waiting for at least delay.
+// A transaction that fails to settle is skipped so it can be retried on the next call.
+//
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - delay: How long a transaction stays PENDING before it is settled
+//   - limit: The maximum number of transactions settled in one call
+//
+// Returns:
+//   - int: The number of transactions that reached a final status
+//   - error: If the pending transactions cannot be retrieved
+func (s *AccountTransactionService) SettlePending(ctx context.Context, delay time.Duration, limit int) (int, error) {
+	pending, err := s.accountTrxRepository.FindPendingBefore(ctx, time.Now().Add(-delay), limit)
+	if err != nil {
+		return 0, err
+	}
+	settled := 0
+	for _, trx := range pending {
+		if _, err := s.Settle(tenant.WithID(ctx, trx.TenantID), trx.ID); err != nil {
+			continue
 		}
-		_, err = s.accountService.UpdateBalance(ctx, accountSrc, tx)
+		settled++
+	}
+	return settled, nil
+}
+
+// Reverse returns the funds of a SUCCESS transaction to its source account and marks it REVERSED.
+// The destination account is debited without a balance check.
+//
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - id: The unique transaction identifier
+//
+// Returns:
+//   - *domain.AccountTransaction: The reversed transaction
+//   - error: If the transaction is not found, is not SUCCESS, or database operation fails
+func (s *AccountTransactionService) Reverse(ctx context.Context, id string) (*domain.AccountTransaction, error) {
+	var accountTrx *domain.AccountTransaction
+	err := s.txManager.Transaction(ctx, func(tx *gorm.DB) error {
+		var err error
+		accountTrx, err = s.accountTrxRepository.FindAndLockByID(ctx, id, tx)
 		if err != nil {
 			return err
 		}
-		_, err = s.accountService.UpdateBalance(ctx, accountDst, tx)
+		if accountTrx.Status != domain.TransactionStatusSuccess {
+			return errors.NewConflict("only successful transaction can be reversed")
+		}
+		accountSrc, accountDst, err := s.lockBalances(ctx, tx, accountTrx.AccountSrcId, accountTrx.AccountDstId)
 		if err != nil {
 			return err
 		}
-		return nil
+		markSettled(accountTrx, domain.TransactionStatusReversed, "reversed")
+		if err := s.accountTrxRepository.Update(ctx, accountTrx, tx); err != nil {
+			return err
+		}
+		return s.moveBalance(ctx, tx, accountDst, accountSrc, accountTrx.Amount)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return accountTrx, nil
+}
+
+func validateCashTransaction(accountID string, amount float64, channel string) error {
+	if accountID == "" {
+		return errors.NewValidationError("account cannot be empty")
+	}
+	if isSettlementAccount(accountID) {
+		return errors.NewValidationError("cannot use settlement account")
+	}
+	if amount <= 0 {
+		return errors.NewValidationError("invalid amount")
+	}
+	if channel == "" {
+		return errors.NewValidationError("channel cannot be empty")
+	}
+	return nil
+}
+
+// isSettlementAccount tells whether accountID is one of the settlement accounts, which may go negative
+// and are therefore only moved through by deposits and withdrawals.
+func isSettlementAccount(accountID string) bool {
+	return accountID == domain.CashInSettlementAccountID || accountID == domain.CashOutSettlementAccountID
+}
+
+// accept records accountTrx as PENDING without touching the balances. Both accounts must exist.
+func (s *AccountTransactionService) accept(ctx context.Context, accountTrx *domain.AccountTransaction) (*domain.AccountTransaction, error) {
+	for _, accountID := range []string{accountTrx.AccountSrcId, accountTrx.AccountDstId} {
+		if _, err := s.accountService.FindByID(ctx, accountID); err != nil {
+			observeTransfer(accountTrx, err)
+			return nil, err
+		}
+	}
+	accountTrx.ID = s.idGenerator.NewID()
+	accountTrx.TransactionTimestamp = time.Now()
+	accountTrx.Status = domain.TransactionStatusPending
+	accountTrx.TenantID = tenant.FromContext(ctx)
+	accountTrx.ClientID = callingClientID(ctx)
+	err := s.txManager.Transaction(ctx, func(tx *gorm.DB) error {
+		return s.accountTrxRepository.Save(ctx, accountTrx, tx)
+	})
+	observeTransfer(accountTrx, err)
+	if err != nil {
+		return nil, err
+	}
+	return accountTrx, nil
+}
+
+// post moves accountTrx.Amount from accountTrx.AccountSrcId to accountTrx.AccountDstId and records
+// accountTrx as SUCCESS within a single database transaction. Both balances are locked before being modified.
+// When checkBalance is false the source account is allowed to go negative regardless of
+// its AllowNegativeBalance flag, which is how settlement accounts are debited.
+func (s *AccountTransactionService) post(ctx context.Context, accountTrx *domain.AccountTransaction, checkBalance bool) (*domain.AccountTransaction, error) {
+	err := s.txManager.Transaction(ctx, func(tx *gorm.DB) error {
+		return s.postInTx(ctx, tx, accountTrx, checkBalance)
 	})
+	observeTransfer(accountTrx, err)
 	if err != nil {
 		return nil, err
 	}
 	return accountTrx, nil
 }
+
+// postInTx does the work of post within tx.
+func (s *AccountTransactionService) postInTx(ctx context.Context, tx *gorm.DB, accountTrx *domain.AccountTransaction, checkBalance bool) error {
+	accountSrc, accountDst, err := s.lockBalances(ctx, tx, accountTrx.AccountSrcId, accountTrx.AccountDstId)
+	if err != nil {
+		return err
+	}
+	if checkBalance && !hasSufficientBalance(accountSrc, accountTrx.Amount) {
+		return errors.NewInsufficientFunds("insufficient amount")
+	}
+	accountTrx.ID = s.idGenerator.NewID()
+	accountTrx.TransactionTimestamp = time.Now()
+	accountTrx.TenantID = tenant.FromContext(ctx)
+	accountTrx.ClientID = callingClientID(ctx)
+	accountTrx.AccountSrc = accountSrc
+	accountTrx.AccountDst = accountDst
+	markSettled(accountTrx, domain.TransactionStatusSuccess, "")
+
+	if err := s.accountTrxRepository.Save(ctx, accountTrx, tx); err != nil {
+		return err
+	}
+	return s.moveBalance(ctx, tx, accountSrc, accountDst, accountTrx.Amount)
+}
+
+func (s *AccountTransactionService) lockBalances(ctx context.Context, tx *gorm.DB, accountSrcID, accountDstID string) (*domain.AccountBalance, *domain.AccountBalance, error) {
+	accountSrc, err := s.accountService.FindAndLockAccountBalance(ctx, accountSrcID, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+	accountDst, err := s.accountService.FindAndLockAccountBalance(ctx, accountDstID, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if accountSrc.TenantID != accountDst.TenantID {
+		return nil, nil, errors.NewForbidden("funds cannot be moved between tenants")
+	}
+	return accountSrc, accountDst, nil
+}
+
+func (s *AccountTransactionService) moveBalance(ctx context.Context, tx *gorm.DB, accountSrc, accountDst *domain.AccountBalance, amount float64) error {
+	accountSrc.Balance = accountSrc.Balance - amount
+	accountDst.Balance = accountDst.Balance + amount
+	if _, err := s.accountService.UpdateBalance(ctx, accountSrc, tx); err != nil {
+		return err
+	}
+	if _, err := s.accountService.UpdateBalance(ctx, accountDst, tx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// observeTransfer records the outcome of a transaction that was just recorded or settled, or that
+// failed with err.
+func observeTransfer(accountTrx *domain.AccountTransaction, err error) {
+	outcome := string(accountTrx.Status)
+	if err != nil {
+		outcome = metrics.OutcomeError
+		if errors.From(err).Status() < http.StatusInternalServerError {
+			outcome = metrics.OutcomeRejected
+		}
+	}
+	metrics.ObserveTransfer(string(accountTrx.Type), outcome, accountTrx.Amount)
+}
+
+func hasSufficientBalance(account *domain.AccountBalance, amount float64) bool {
+	return account.Balance-amount >= 0 || account.AllowNegativeBalance
+}
+
+func markSettled(accountTrx *domain.AccountTransaction, status domain.TransactionStatus, reason string) {
+	now := time.Now()
+	accountTrx.Status = status
+	accountTrx.StatusReason = reason
+	accountTrx.SettledAt = &now
+}
+
+// callingClientID returns the ID of the API client the request is made on behalf of, or an
+// empty string when the request is not authenticated.
+func callingClientID(ctx context.Context) string {
+	if principal, ok := auth.PrincipalFrom(ctx); ok {
+		return principal.ClientID
+	}
+	return ""
+}
@@ -5,11 +5,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/mrth1995/go-mockva/pkg/auth"
 	"github.com/mrth1995/go-mockva/pkg/domain"
 	pkgErrors "github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/idgen"
 	"github.com/mrth1995/go-mockva/pkg/model"
+	"github.com/mrth1995/go-mockva/pkg/repository"
 	mockRepo "github.com/mrth1995/go-mockva/pkg/repository/mock"
 	mockService "github.com/mrth1995/go-mockva/pkg/service/mock"
+	"github.com/mrth1995/go-mockva/pkg/tenant"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"gorm.io/gorm"
@@ -80,6 +84,24 @@ func TestAccountTransactionService_Transfer_ValidationErrors(t *testing.T) {
 			},
 			expectedErr: "cannot transfer with same account",
 		},
+		{
+			name: "Cash-in settlement source",
+			transfer: &model.AccountFundTransfer{
+				AccountSrcID: domain.CashInSettlementAccountID,
+				AccountDstID: "002",
+				Amount:       100000,
+			},
+			expectedErr: "cannot use settlement account",
+		},
+		{
+			name: "Cash-out settlement destination",
+			transfer: &model.AccountFundTransfer{
+				AccountSrcID: "001",
+				AccountDstID: domain.CashOutSettlementAccountID,
+				Amount:       100000,
+			},
+			expectedErr: "cannot use settlement account",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -89,6 +111,7 @@ func TestAccountTransactionService_Transfer_ValidationErrors(t *testing.T) {
 			assertions.Nil(result)
 			assertions.NotNil(err)
 			assertions.Contains(err.Error(), tc.expectedErr)
+			assertions.ErrorIs(err, pkgErrors.ErrValidation)
 		})
 	}
 }
@@ -111,32 +134,32 @@ func TestAccountTransactionService_Transfer(t *testing.T) {
 	txManager := mockRepo.NewMockDBTransactionManager(ctrl)
 
 	txManager.EXPECT().
-		Transaction(gomock.Any()).
-		DoAndReturn(func(fc func(tx *gorm.DB) error) error {
+		Transaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
 			return fc(nil)
 		})
 
 	accountService.EXPECT().
-		FindAndLockAccountBalance(ctx, accountSrc.ID).
+		FindAndLockAccountBalance(gomock.Any(), accountSrc.ID, gomock.Any()).
 		Return(accountSrc, nil)
 
 	accountService.EXPECT().
-		FindAndLockAccountBalance(ctx, accountDst.ID).
+		FindAndLockAccountBalance(gomock.Any(), accountDst.ID, gomock.Any()).
 		Return(accountDst, nil)
 
 	accountTrxRepo.EXPECT().
-		Save(gomock.Any(), gomock.Any()).
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil)
 
 	accountService.EXPECT().
-		UpdateBalance(ctx, gomock.Any(), gomock.Any()).
+		UpdateBalance(gomock.Any(), gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, bal *domain.AccountBalance, tx *gorm.DB) (*domain.AccountBalance, error) {
 			require.Equal(t, initialSrcBalance-100_000, bal.Balance)
 			return bal, nil
 		})
 
 	accountService.EXPECT().
-		UpdateBalance(ctx, gomock.Any(), gomock.Any()).
+		UpdateBalance(gomock.Any(), gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, bal *domain.AccountBalance, tx *gorm.DB) (*domain.AccountBalance, error) {
 			require.Equal(t, initialDstBalance+100_000, bal.Balance)
 			return bal, nil
@@ -158,6 +181,7 @@ func TestAccountTransactionService_Transfer(t *testing.T) {
 	assertions.Equal(accountSrc.ID, accountTransaction.AccountSrc.ID, "Source account should match")
 	assertions.Equal(accountDst.ID, accountTransaction.AccountDst.ID, "Destination account should match")
 	assertions.Equal(accountFundTransfer.Amount, accountTransaction.Amount, "Amount should match")
+	assertions.Equal(domain.TransactionStatusSuccess, accountTransaction.Status, "Transaction should be settled")
 }
 
 func TestAccountTransactionService_TransferNegativeBalance(t *testing.T) {
@@ -177,15 +201,15 @@ func TestAccountTransactionService_TransferNegativeBalance(t *testing.T) {
 	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
 	txManager := mockRepo.NewMockDBTransactionManager(ctrl)
 
-	txManager.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fc func(tx *gorm.DB) error) error {
+	txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
 		return fc(nil)
 	})
 
-	accountService.EXPECT().FindAndLockAccountBalance(ctx, accountSrc.ID).Return(accountSrc, nil)
-	accountService.EXPECT().FindAndLockAccountBalance(ctx, accountDst.ID).Return(accountDst, nil)
-	accountTrxRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
-	accountService.EXPECT().UpdateBalance(ctx, gomock.Any(), gomock.Any()).Return(accountSrc, nil)
-	accountService.EXPECT().UpdateBalance(ctx, gomock.Any(), gomock.Any()).Return(accountDst, nil)
+	accountService.EXPECT().FindAndLockAccountBalance(gomock.Any(), accountSrc.ID, gomock.Any()).Return(accountSrc, nil)
+	accountService.EXPECT().FindAndLockAccountBalance(gomock.Any(), accountDst.ID, gomock.Any()).Return(accountDst, nil)
+	accountTrxRepo.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	accountService.EXPECT().UpdateBalance(gomock.Any(), gomock.Any(), gomock.Any()).Return(accountSrc, nil)
+	accountService.EXPECT().UpdateBalance(gomock.Any(), gomock.Any(), gomock.Any()).Return(accountDst, nil)
 
 	accountTrxService := NewAccountTrxService(accountService, accountTrxRepo, txManager)
 
@@ -218,12 +242,12 @@ func TestAccountTransactionServiceImpl_Transfer_InsufficientFunds(t *testing.T)
 	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
 	txManager := mockRepo.NewMockDBTransactionManager(ctrl)
 
-	txManager.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fc func(tx *gorm.DB) error) error {
+	txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
 		return fc(nil)
 	})
 
-	accountService.EXPECT().FindAndLockAccountBalance(ctx, accountSrc.ID).Return(accountSrc, nil)
-	accountService.EXPECT().FindAndLockAccountBalance(ctx, accountDst.ID).Return(accountDst, nil)
+	accountService.EXPECT().FindAndLockAccountBalance(gomock.Any(), accountSrc.ID, gomock.Any()).Return(accountSrc, nil)
+	accountService.EXPECT().FindAndLockAccountBalance(gomock.Any(), accountDst.ID, gomock.Any()).Return(accountDst, nil)
 
 	accountTrxService := NewAccountTrxService(accountService, accountTrxRepo, txManager)
 
@@ -237,6 +261,7 @@ func TestAccountTransactionServiceImpl_Transfer_InsufficientFunds(t *testing.T)
 	assertions.Nil(transaction)
 	assertions.NotNil(err, "Insufficient funds")
 	assertions.Contains(err.Error(), "insufficient amount")
+	assertions.ErrorIs(err, pkgErrors.ErrInsufficientFunds)
 }
 
 func TestAccountTransactionServiceImpl_Transfer_AccountSrcNotFound(t *testing.T) {
@@ -252,12 +277,12 @@ func TestAccountTransactionServiceImpl_Transfer_AccountSrcNotFound(t *testing.T)
 	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
 	txManager := mockRepo.NewMockDBTransactionManager(ctrl)
 
-	txManager.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fc func(tx *gorm.DB) error) error {
+	txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
 		return fc(nil)
 	})
 
 	accountService.EXPECT().
-		FindAndLockAccountBalance(ctx, accountSrc.ID).
+		FindAndLockAccountBalance(gomock.Any(), accountSrc.ID, gomock.Any()).
 		Return(nil, pkgErrors.NewAccountNotFound(accountSrc.ID))
 
 	accountTrxService := NewAccountTrxService(accountService, accountTrxRepo, txManager)
@@ -273,6 +298,41 @@ func TestAccountTransactionServiceImpl_Transfer_AccountSrcNotFound(t *testing.T)
 	assertions.NotNil(err, "account src not found")
 }
 
+func TestAccountTransactionService_Transfer_CrossTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := tenant.WithID(context.Background(), "team-a")
+
+	accountSrc := getAccountBalance(getAccountSrc(), 1_000_000)
+	accountSrc.TenantID = "team-a"
+	accountDst := getAccountBalance(getAccountDst(), 0)
+	accountDst.TenantID = "team-b"
+
+	accountService := mockService.NewMockAccountService(ctrl)
+	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
+	txManager := mockRepo.NewMockDBTransactionManager(ctrl)
+
+	txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
+		return fc(nil)
+	})
+	accountService.EXPECT().FindAndLockAccountBalance(gomock.Any(), accountSrc.ID, gomock.Any()).Return(accountSrc, nil)
+	accountService.EXPECT().FindAndLockAccountBalance(gomock.Any(), accountDst.ID, gomock.Any()).Return(accountDst, nil)
+
+	accountTrxService := NewAccountTrxService(accountService, accountTrxRepo, txManager)
+
+	transaction, err := accountTrxService.Transfer(ctx, &model.AccountFundTransfer{
+		AccountDstID: accountDst.ID,
+		AccountSrcID: accountSrc.ID,
+		Amount:       100_000,
+	})
+
+	assertions := require.New(t)
+	assertions.Nil(transaction)
+	assertions.True(pkgErrors.Is(err, pkgErrors.ErrForbidden), "funds never move between tenants")
+	assertions.Equal(float64(1_000_000), accountSrc.Balance)
+}
+
 func TestAccountTransactionServiceImpl_Transfer_AccountDstNotFound(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -287,13 +347,13 @@ func TestAccountTransactionServiceImpl_Transfer_AccountDstNotFound(t *testing.T)
 	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
 	txManager := mockRepo.NewMockDBTransactionManager(ctrl)
 
-	txManager.EXPECT().Transaction(gomock.Any()).DoAndReturn(func(fc func(tx *gorm.DB) error) error {
+	txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
 		return fc(nil)
 	})
 
-	accountService.EXPECT().FindAndLockAccountBalance(ctx, accountSrc.ID).Return(accountSrc, nil)
+	accountService.EXPECT().FindAndLockAccountBalance(gomock.Any(), accountSrc.ID, gomock.Any()).Return(accountSrc, nil)
 	accountService.EXPECT().
-		FindAndLockAccountBalance(ctx, accountDst.ID).
+		FindAndLockAccountBalance(gomock.Any(), accountDst.ID, gomock.Any()).
 		Return(nil, pkgErrors.NewAccountNotFound(accountDst.ID))
 
 	accountTrxService := NewAccountTrxService(accountService, accountTrxRepo, txManager)
@@ -309,6 +369,548 @@ func TestAccountTransactionServiceImpl_Transfer_AccountDstNotFound(t *testing.T)
 	assertions.NotNil(err, "Account dst not found")
 }
 
+func TestAccountTransactionService_Deposit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{ClientID: "client-001"})
+
+	settlement := getAccountBalance(getSettlementAccount(domain.CashInSettlementAccountID), 0)
+	initialDstBalance := float64(200_000)
+	accountDst := getAccountBalance(getAccountDst(), initialDstBalance)
+
+	accountService := mockService.NewMockAccountService(ctrl)
+	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
+	txManager := mockRepo.NewMockDBTransactionManager(ctrl)
+
+	txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
+		return fc(nil)
+	})
+
+	accountService.EXPECT().FindAndLockAccountBalance(ctx, domain.CashInSettlementAccountID, gomock.Any()).Return(settlement, nil)
+	accountService.EXPECT().FindAndLockAccountBalance(ctx, accountDst.ID, gomock.Any()).Return(accountDst, nil)
+	accountTrxRepo.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	accountService.EXPECT().
+		UpdateBalance(ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, bal *domain.AccountBalance, tx *gorm.DB) (*domain.AccountBalance, error) {
+			require.Equal(t, float64(-50_000), bal.Balance)
+			return bal, nil
+		})
+	accountService.EXPECT().
+		UpdateBalance(ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, bal *domain.AccountBalance, tx *gorm.DB) (*domain.AccountBalance, error) {
+			require.Equal(t, initialDstBalance+50_000, bal.Balance)
+			return bal, nil
+		})
+
+	accountTrxService := NewAccountTrxService(accountService, accountTrxRepo, txManager)
+
+	accountTransaction, err := accountTrxService.Deposit(ctx, &model.AccountDeposit{
+		AccountID: accountDst.ID,
+		Amount:    50_000,
+		Channel:   "ATM",
+		Reference: "REF-001",
+	})
+
+	assertions := require.New(t)
+	assertions.Nil(err)
+	assertions.NotNil(accountTransaction, "Account transaction created")
+	assertions.Equal(domain.TransactionTypeDeposit, accountTransaction.Type)
+	assertions.Equal(domain.CashInSettlementAccountID, accountTransaction.AccountSrc.ID)
+	assertions.Equal(accountDst.ID, accountTransaction.AccountDst.ID)
+	assertions.Equal("ATM", accountTransaction.Channel)
+	assertions.Equal("REF-001", accountTransaction.Reference)
+	assertions.Equal("client-001", accountTransaction.ClientID, "the calling client is recorded")
+	assertions.Equal(tenant.DefaultID, accountTransaction.TenantID, "requests naming no tenant operate on the default one")
+}
+
+func TestAccountTransactionService_Withdraw(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	initialSrcBalance := float64(100_000)
+	accountSrc := getAccountBalance(getAccountSrc(), initialSrcBalance)
+	settlement := getAccountBalance(getSettlementAccount(domain.CashOutSettlementAccountID), 0)
+
+	accountService := mockService.NewMockAccountService(ctrl)
+	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
+	txManager := mockRepo.NewMockDBTransactionManager(ctrl)
+
+	txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
+		return fc(nil)
+	})
+
+	accountService.EXPECT().FindAndLockAccountBalance(ctx, accountSrc.ID, gomock.Any()).Return(accountSrc, nil)
+	accountService.EXPECT().FindAndLockAccountBalance(ctx, domain.CashOutSettlementAccountID, gomock.Any()).Return(settlement, nil)
+	accountTrxRepo.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	accountService.EXPECT().UpdateBalance(ctx, gomock.Any(), gomock.Any()).Return(accountSrc, nil)
+	accountService.EXPECT().UpdateBalance(ctx, gomock.Any(), gomock.Any()).Return(settlement, nil)
+
+	accountTrxService := NewAccountTrxService(accountService, accountTrxRepo, txManager)
+
+	accountTransaction, err := accountTrxService.Withdraw(ctx, &model.AccountWithdrawal{
+		AccountID: accountSrc.ID,
+		Amount:    40_000,
+		Channel:   "TELLER",
+	})
+
+	assertions := require.New(t)
+	assertions.Nil(err)
+	assertions.NotNil(accountTransaction, "Account transaction created")
+	assertions.Equal(domain.TransactionTypeWithdrawal, accountTransaction.Type)
+	assertions.Equal(initialSrcBalance-40_000, accountSrc.Balance)
+	assertions.Equal(float64(40_000), settlement.Balance)
+}
+
+func TestAccountTransactionService_Withdraw_InsufficientFunds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	accountSrc := getAccountBalance(getAccountSrc(), 10_000)
+	settlement := getAccountBalance(getSettlementAccount(domain.CashOutSettlementAccountID), 0)
+
+	accountService := mockService.NewMockAccountService(ctrl)
+	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
+	txManager := mockRepo.NewMockDBTransactionManager(ctrl)
+
+	txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
+		return fc(nil)
+	})
+
+	accountService.EXPECT().FindAndLockAccountBalance(ctx, accountSrc.ID, gomock.Any()).Return(accountSrc, nil)
+	accountService.EXPECT().FindAndLockAccountBalance(ctx, domain.CashOutSettlementAccountID, gomock.Any()).Return(settlement, nil)
+
+	accountTrxService := NewAccountTrxService(accountService, accountTrxRepo, txManager)
+
+	transaction, err := accountTrxService.Withdraw(ctx, &model.AccountWithdrawal{
+		AccountID: accountSrc.ID,
+		Amount:    40_000,
+		Channel:   "TELLER",
+	})
+
+	assertions := require.New(t)
+	assertions.Nil(transaction)
+	assertions.NotNil(err, "Insufficient funds")
+	assertions.Contains(err.Error(), "insufficient amount")
+}
+
+func TestAccountTransactionService_Deposit_ValidationErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	accountTrxService := &AccountTransactionService{
+		accountService: mockService.NewMockAccountService(ctrl),
+	}
+
+	testCases := []struct {
+		name        string
+		deposit     *model.AccountDeposit
+		expectedErr string
+	}{
+		{
+			name:        "Empty account",
+			deposit:     &model.AccountDeposit{Amount: 100, Channel: "ATM"},
+			expectedErr: "account cannot be empty",
+		},
+		{
+			name:        "Settlement account",
+			deposit:     &model.AccountDeposit{AccountID: domain.CashInSettlementAccountID, Amount: 100, Channel: "ATM"},
+			expectedErr: "cannot use settlement account",
+		},
+		{
+			name:        "Invalid amount",
+			deposit:     &model.AccountDeposit{AccountID: "001", Amount: 0, Channel: "ATM"},
+			expectedErr: "invalid amount",
+		},
+		{
+			name:        "Empty channel",
+			deposit:     &model.AccountDeposit{AccountID: "001", Amount: 100},
+			expectedErr: "channel cannot be empty",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := accountTrxService.Deposit(ctx, tc.deposit)
+			assertions := require.New(t)
+			assertions.Nil(result)
+			assertions.NotNil(err)
+			assertions.Contains(err.Error(), tc.expectedErr)
+		})
+	}
+}
+
+func TestAccountTransactionService_Transfer_Async(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	accountSrc := getAccountSrc()
+	accountDst := getAccountDst()
+
+	accountService := mockService.NewMockAccountService(ctrl)
+	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
+	txManager := mockRepo.NewMockDBTransactionManager(ctrl)
+
+	txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
+		return fc(nil)
+	})
+
+	accountService.EXPECT().FindByID(gomock.Any(), accountSrc.ID).Return(accountSrc, nil)
+	accountService.EXPECT().FindByID(gomock.Any(), accountDst.ID).Return(accountDst, nil)
+	accountTrxRepo.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	accountTrxService := NewAccountTrxService(accountService, accountTrxRepo, txManager, WithAsyncTransfer(true))
+
+	accountTransaction, err := accountTrxService.Transfer(ctx, &model.AccountFundTransfer{
+		AccountDstID: accountDst.ID,
+		AccountSrcID: accountSrc.ID,
+		Amount:       100_000,
+	})
+
+	assertions := require.New(t)
+	assertions.Nil(err)
+	assertions.NotNil(accountTransaction, "Account transaction created")
+	assertions.Equal(domain.TransactionStatusPending, accountTransaction.Status)
+	assertions.Nil(accountTransaction.SettledAt, "Pending transaction should not be settled")
+	assertions.Equal(accountSrc.ID, accountTransaction.AccountSrcId)
+	assertions.Equal(accountDst.ID, accountTransaction.AccountDstId)
+}
+
+func TestAccountTransactionService_Settle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	accountSrc := getAccountBalance(getAccountSrc(), 1_000_000)
+	accountDst := getAccountBalance(getAccountDst(), 0)
+	pending := getPendingTransaction(accountSrc.ID, accountDst.ID, 100_000)
+
+	accountService := mockService.NewMockAccountService(ctrl)
+	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
+	txManager := mockRepo.NewMockDBTransactionManager(ctrl)
+
+	txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
+		return fc(nil)
+	})
+
+	accountTrxRepo.EXPECT().FindAndLockByID(ctx, pending.ID, gomock.Any()).Return(pending, nil)
+	accountService.EXPECT().FindAndLockAccountBalance(ctx, accountSrc.ID, gomock.Any()).Return(accountSrc, nil)
+	accountService.EXPECT().FindAndLockAccountBalance(ctx, accountDst.ID, gomock.Any()).Return(accountDst, nil)
+	accountTrxRepo.EXPECT().Update(gomock.Any(), pending, gomock.Any()).Return(nil)
+	accountService.EXPECT().UpdateBalance(ctx, accountSrc, gomock.Any()).Return(accountSrc, nil)
+	accountService.EXPECT().UpdateBalance(ctx, accountDst, gomock.Any()).Return(accountDst, nil)
+
+	accountTrxService := NewAccountTrxService(accountService, accountTrxRepo, txManager, WithAsyncTransfer(true))
+
+	accountTransaction, err := accountTrxService.Settle(ctx, pending.ID)
+
+	assertions := require.New(t)
+	assertions.Nil(err)
+	assertions.Equal(domain.TransactionStatusSuccess, accountTransaction.Status)
+	assertions.NotNil(accountTransaction.SettledAt)
+	assertions.Equal(float64(900_000), accountSrc.Balance)
+	assertions.Equal(float64(100_000), accountDst.Balance)
+}
+
+func TestAccountTransactionService_Settle_InsufficientFunds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	accountSrc := getAccountBalance(getAccountSrc(), 50_000)
+	accountDst := getAccountBalance(getAccountDst(), 0)
+	pending := getPendingTransaction(accountSrc.ID, accountDst.ID, 100_000)
+
+	accountService := mockService.NewMockAccountService(ctrl)
+	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
+	txManager := mockRepo.NewMockDBTransactionManager(ctrl)
+
+	txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
+		return fc(nil)
+	})
+
+	accountTrxRepo.EXPECT().FindAndLockByID(ctx, pending.ID, gomock.Any()).Return(pending, nil)
+	accountService.EXPECT().FindAndLockAccountBalance(ctx, accountSrc.ID, gomock.Any()).Return(accountSrc, nil)
+	accountService.EXPECT().FindAndLockAccountBalance(ctx, accountDst.ID, gomock.Any()).Return(accountDst, nil)
+	accountTrxRepo.EXPECT().Update(gomock.Any(), pending, gomock.Any()).Return(nil)
+
+	accountTrxService := NewAccountTrxService(accountService, accountTrxRepo, txManager, WithAsyncTransfer(true))
+
+	accountTransaction, err := accountTrxService.Settle(ctx, pending.ID)
+
+	assertions := require.New(t)
+	assertions.Nil(err)
+	assertions.Equal(domain.TransactionStatusFailed, accountTransaction.Status)
+	assertions.Equal("insufficient amount", accountTransaction.StatusReason)
+	assertions.Equal(float64(50_000), accountSrc.Balance, "Balance should not change")
+}
+
+func TestAccountTransactionService_Settle_AlreadySettled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	settled := getPendingTransaction("001", "002", 100_000)
+	settled.Status = domain.TransactionStatusSuccess
+
+	accountService := mockService.NewMockAccountService(ctrl)
+	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
+	txManager := mockRepo.NewMockDBTransactionManager(ctrl)
+
+	txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
+		return fc(nil)
+	})
+	accountTrxRepo.EXPECT().FindAndLockByID(ctx, settled.ID, gomock.Any()).Return(settled, nil)
+
+	accountTrxService := NewAccountTrxService(accountService, accountTrxRepo, txManager, WithAsyncTransfer(true))
+
+	accountTransaction, err := accountTrxService.Settle(ctx, settled.ID)
+
+	assertions := require.New(t)
+	assertions.Nil(err)
+	assertions.Equal(domain.TransactionStatusSuccess, accountTransaction.Status)
+}
+
+func TestAccountTransactionService_Reverse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	accountSrc := getAccountBalance(getAccountSrc(), 900_000)
+	accountDst := getAccountBalance(getAccountDst(), 100_000)
+	trx := getPendingTransaction(accountSrc.ID, accountDst.ID, 100_000)
+	trx.Status = domain.TransactionStatusSuccess
+
+	accountService := mockService.NewMockAccountService(ctrl)
+	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
+	txManager := mockRepo.NewMockDBTransactionManager(ctrl)
+
+	txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
+		return fc(nil)
+	})
+
+	accountTrxRepo.EXPECT().FindAndLockByID(ctx, trx.ID, gomock.Any()).Return(trx, nil)
+	accountService.EXPECT().FindAndLockAccountBalance(ctx, accountSrc.ID, gomock.Any()).Return(accountSrc, nil)
+	accountService.EXPECT().FindAndLockAccountBalance(ctx, accountDst.ID, gomock.Any()).Return(accountDst, nil)
+	accountTrxRepo.EXPECT().Update(gomock.Any(), trx, gomock.Any()).Return(nil)
+	accountService.EXPECT().UpdateBalance(ctx, accountDst, gomock.Any()).Return(accountDst, nil)
+	accountService.EXPECT().UpdateBalance(ctx, accountSrc, gomock.Any()).Return(accountSrc, nil)
+
+	accountTrxService := NewAccountTrxService(accountService, accountTrxRepo, txManager)
+
+	accountTransaction, err := accountTrxService.Reverse(ctx, trx.ID)
+
+	assertions := require.New(t)
+	assertions.Nil(err)
+	assertions.Equal(domain.TransactionStatusReversed, accountTransaction.Status)
+	assertions.Equal(float64(1_000_000), accountSrc.Balance)
+	assertions.Equal(float64(0), accountDst.Balance)
+}
+
+func TestAccountTransactionService_Reverse_NotSuccessful(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	trx := getPendingTransaction("001", "002", 100_000)
+
+	accountService := mockService.NewMockAccountService(ctrl)
+	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
+	txManager := mockRepo.NewMockDBTransactionManager(ctrl)
+
+	txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
+		return fc(nil)
+	})
+	accountTrxRepo.EXPECT().FindAndLockByID(ctx, trx.ID, gomock.Any()).Return(trx, nil)
+
+	accountTrxService := NewAccountTrxService(accountService, accountTrxRepo, txManager)
+
+	accountTransaction, err := accountTrxService.Reverse(ctx, trx.ID)
+
+	assertions := require.New(t)
+	assertions.Nil(accountTransaction)
+	assertions.NotNil(err)
+	assertions.Contains(err.Error(), "only successful transaction can be reversed")
+	assertions.ErrorIs(err, pkgErrors.ErrConflict)
+}
+
+func TestAccountTransactionService_FindInfoByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	accountSrc := getAccountSrc()
+	accountDst := getAccountDst()
+	trx := getPendingTransaction(accountSrc.ID, accountDst.ID, 100_000)
+	trx.Status = domain.TransactionStatusFailed
+	trx.StatusReason = "insufficient amount"
+
+	accountService := mockService.NewMockAccountService(ctrl)
+	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
+
+	accountTrxRepo.EXPECT().FindByID(ctx, trx.ID).Return(trx, nil)
+	accountService.EXPECT().FindByID(ctx, accountSrc.ID).Return(accountSrc, nil)
+	accountService.EXPECT().FindByID(ctx, accountDst.ID).Return(accountDst, nil)
+
+	accountTrxService := NewAccountTrxService(accountService, accountTrxRepo, nil)
+
+	trxInfo, err := accountTrxService.FindInfoByID(ctx, trx.ID)
+
+	assertions := require.New(t)
+	assertions.Nil(err)
+	assertions.Equal(trx.ID, trxInfo.ID)
+	assertions.Equal(accountSrc.Name, trxInfo.AccountSrcName)
+	assertions.Equal(accountDst.Name, trxInfo.AccountDstName)
+	assertions.Equal("FAILED", trxInfo.Status)
+	assertions.Equal("insufficient amount", trxInfo.StatusRemark)
+}
+
+func TestAccountTransactionService_FindInfoByID_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
+	accountTrxRepo.EXPECT().FindByID(ctx, "TRX-404").Return(nil, pkgErrors.NewTransactionNotFound("TRX-404"))
+
+	accountTrxService := NewAccountTrxService(mockService.NewMockAccountService(ctrl), accountTrxRepo, nil)
+
+	trxInfo, err := accountTrxService.FindInfoByID(ctx, "TRX-404")
+
+	assertions := require.New(t)
+	assertions.Nil(trxInfo)
+	assertions.NotNil(err, "Transaction not found")
+}
+
+func TestAccountTransactionService_Transfer_References(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	accountSrc := getAccountBalance(getAccountSrc(), 1_000_000)
+	accountDst := getAccountBalance(getAccountDst(), 0)
+
+	accountService := mockService.NewMockAccountService(ctrl)
+	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
+	txManager := mockRepo.NewMockDBTransactionManager(ctrl)
+
+	txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
+		return fc(nil)
+	})
+	accountService.EXPECT().FindAndLockAccountBalance(gomock.Any(), accountSrc.ID, gomock.Any()).Return(accountSrc, nil)
+	accountService.EXPECT().FindAndLockAccountBalance(gomock.Any(), accountDst.ID, gomock.Any()).Return(accountDst, nil)
+
+	var savedTrx *domain.AccountTransaction
+	accountTrxRepo.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, trx *domain.AccountTransaction, tx *gorm.DB) error {
+			savedTrx = trx
+			return nil
+		})
+	accountService.EXPECT().UpdateBalance(gomock.Any(), gomock.Any(), gomock.Any()).Return(accountSrc, nil).Times(2)
+
+	generator, _ := idgen.New(idgen.KindULID, 42)
+	expectedGenerator, _ := idgen.New(idgen.KindULID, 42)
+	accountTrxService := NewAccountTrxService(accountService, accountTrxRepo, txManager, WithIDGenerator(generator))
+
+	_, err := accountTrxService.Transfer(ctx, &model.AccountFundTransfer{
+		AccountDstID:      accountDst.ID,
+		AccountSrcID:      accountSrc.ID,
+		Amount:            100_000,
+		Remark:            "Invoice 42",
+		ExternalReference: "EXT-42",
+		PurposeCode:       "01",
+		Metadata:          map[string]string{"invoice": "42"},
+	})
+
+	assertions := require.New(t)
+	assertions.Nil(err)
+	assertions.Equal(expectedGenerator.NewID(), savedTrx.ID, "Seeded generator should produce a reproducible ID")
+	assertions.Equal("Invoice 42", savedTrx.Remark)
+	assertions.Equal("EXT-42", savedTrx.ExternalReference)
+	assertions.Equal("01", savedTrx.PurposeCode)
+	assertions.Equal(map[string]string{"invoice": "42"}, savedTrx.Metadata)
+}
+
+func TestAccountTransactionService_Search(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	accountSrc := getAccountSrc()
+	accountDst := getAccountDst()
+	trx := getPendingTransaction(accountSrc.ID, accountDst.ID, 100_000)
+	trx.ExternalReference = "EXT-42"
+
+	accountService := mockService.NewMockAccountService(ctrl)
+	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
+
+	accountTrxRepo.EXPECT().
+		Search(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, filter *repository.AccountTransactionFilter) ([]domain.AccountTransaction, int64, error) {
+			require.Equal(t, "EXT-42", filter.ExternalReference)
+			require.Equal(t, map[string]string{"invoice": "42"}, filter.Metadata)
+			require.Equal(t, 10, filter.Offset)
+			require.Equal(t, 10, filter.Limit)
+			return []domain.AccountTransaction{*trx}, 11, nil
+		})
+	accountService.EXPECT().FindByID(ctx, accountSrc.ID).Return(accountSrc, nil)
+	accountService.EXPECT().FindByID(ctx, accountDst.ID).Return(accountDst, nil)
+
+	accountTrxService := NewAccountTrxService(accountService, accountTrxRepo, nil)
+
+	trxPage, err := accountTrxService.Search(ctx, &model.AccountTransactionSearch{
+		ExternalReference: "EXT-42",
+		Metadata:          map[string]string{"invoice": "42"},
+		Page:              2,
+		Size:              10,
+	})
+
+	assertions := require.New(t)
+	assertions.Nil(err)
+	assertions.Equal(int64(11), trxPage.Total)
+	assertions.Len(trxPage.Items, 1)
+	assertions.Equal("EXT-42", trxPage.Items[0].ExternalReference)
+	assertions.Equal(accountSrc.Name, trxPage.Items[0].AccountSrcName)
+}
+
+func getPendingTransaction(accountSrcID, accountDstID string, amount float64) *domain.AccountTransaction {
+	return &domain.AccountTransaction{
+		ID:                   "TRX-001",
+		TransactionTimestamp: time.Now(),
+		Amount:               amount,
+		Type:                 domain.TransactionTypeTransfer,
+		Status:               domain.TransactionStatusPending,
+		AccountSrcId:         accountSrcID,
+		AccountDstId:         accountDstID,
+	}
+}
+
+func getSettlementAccount(id string) *domain.Account {
+	return &domain.Account{
+		ID:        id,
+		AccountID: id,
+		Name:      id,
+	}
+}
+
 func getAccountSrc() *domain.Account {
 	addr := "Jl sadarmanah"
 	birthDate, err := time.Parse(time.DateOnly, "1995-03-01")
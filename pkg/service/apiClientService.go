@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	"github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/model"
+	"github.com/mrth1995/go-mockva/pkg/repository"
+	"github.com/mrth1995/go-mockva/pkg/signature"
+)
+
+// APIClientService manages the partners allowed to call mockva and what they may do.
+type APIClientService struct {
+	apiClientRepository repository.APIClientRepository
+	tenantRepository    repository.TenantRepository
+}
+
+// NewAPIClientService creates a new APIClientService.
+func NewAPIClientService(apiClientRepo repository.APIClientRepository, tenantRepo repository.TenantRepository) *APIClientService {
+	return &APIClientService{apiClientRepository: apiClientRepo, tenantRepository: tenantRepo}
+}
+
+// FindByID retrieves an API client by its client ID.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - id: The client ID
+//
+// Returns:
+//   - *domain.APIClient: The API client if found
+//   - error: If the API client is not found or a database error occurs
+func (s *APIClientService) FindByID(ctx context.Context, id string) (*domain.APIClient, error) {
+	return s.apiClientRepository.FindByID(ctx, id)
+}
+
+// FindAll retrieves every API client ordered by client ID.
+func (s *APIClientService) FindAll(ctx context.Context) ([]domain.APIClient, error) {
+	return s.apiClientRepository.FindAll(ctx)
+}
+
+// Create registers an API client and generates its API secret.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - register: The client ID, name, keys and access rules
+//
+// Returns:
+//   - *domain.APIClient: The registered API client
+//   - string: The API secret, which cannot be retrieved later
+//   - error: If the client already exists, a key, scope, IP or tenant is invalid, or a database error occurs
+func (s *APIClientService) Create(ctx context.Context, register *model.APIClientRegister) (*domain.APIClient, string, error) {
+	if err := validateAPIClientAccess(register.PublicKey, register.Scopes, register.AllowedIPs); err != nil {
+		return nil, "", err
+	}
+	if err := s.checkTenant(ctx, register.TenantID); err != nil {
+		return nil, "", err
+	}
+	_, err := s.apiClientRepository.FindByID(ctx, register.ID)
+	if err == nil {
+		return nil, "", errors.NewConflict("API client " + register.ID + " already exist")
+	}
+	if !errors.Is(err, errors.ErrAPIClientNotFound) {
+		return nil, "", err
+	}
+	secret, err := newAPISecret()
+	if err != nil {
+		return nil, "", err
+	}
+	now := time.Now()
+	client := &domain.APIClient{
+		ID:           register.ID,
+		Name:         register.Name,
+		TenantID:     register.TenantID,
+		SecretHash:   hashAPISecret(secret),
+		PublicKey:    register.PublicKey,
+		ClientSecret: register.ClientSecret,
+		Scopes:       register.Scopes,
+		AllowedIPs:   register.AllowedIPs,
+		Enabled:      register.Enabled == nil || *register.Enabled,
+		RateLimit:    register.RateLimit,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if err = s.apiClientRepository.Save(ctx, client); err != nil {
+		return nil, "", err
+	}
+	return client, secret, nil
+}
+
+// Edit updates the fields of an API client that are set in edit.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - id: The client ID
+//   - edit: The fields to update
+//
+// Returns:
+//   - *domain.APIClient: The updated API client
+//   - error: If the client is not found, a key, scope, IP or tenant is invalid, or a database error occurs
+func (s *APIClientService) Edit(ctx context.Context, id string, edit *model.APIClientEdit) (*domain.APIClient, error) {
+	client, err := s.apiClientRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if edit.Name != nil {
+		client.Name = *edit.Name
+	}
+	if edit.PublicKey != nil {
+		client.PublicKey = *edit.PublicKey
+	}
+	if edit.ClientSecret != nil {
+		client.ClientSecret = *edit.ClientSecret
+	}
+	if edit.Scopes != nil {
+		client.Scopes = *edit.Scopes
+	}
+	if edit.AllowedIPs != nil {
+		client.AllowedIPs = *edit.AllowedIPs
+	}
+	if edit.Enabled != nil {
+		client.Enabled = *edit.Enabled
+	}
+	if edit.RateLimit != nil {
+		client.RateLimit = *edit.RateLimit
+	}
+	if edit.TenantID != nil && *edit.TenantID != client.TenantID {
+		if err = s.checkTenant(ctx, *edit.TenantID); err != nil {
+			return nil, err
+		}
+		client.TenantID = *edit.TenantID
+	}
+	if err = validateAPIClientAccess(client.PublicKey, client.Scopes, client.AllowedIPs); err != nil {
+		return nil, err
+	}
+	client.UpdatedAt = time.Now()
+	if err = s.apiClientRepository.Save(ctx, client); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// RotateSecret replaces the API secret of a client. The previous secret stops working immediately.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - id: The client ID
+//
+// Returns:
+//   - *domain.APIClient: The API client
+//   - string: The new API secret, which cannot be retrieved later
+//   - error: If the client is not found or a database error occurs
+func (s *APIClientService) RotateSecret(ctx context.Context, id string) (*domain.APIClient, string, error) {
+	client, err := s.apiClientRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	secret, err := newAPISecret()
+	if err != nil {
+		return nil, "", err
+	}
+	client.SecretHash = hashAPISecret(secret)
+	client.UpdatedAt = time.Now()
+	if err = s.apiClientRepository.Save(ctx, client); err != nil {
+		return nil, "", err
+	}
+	return client, secret, nil
+}
+
+// Authenticate verifies the API secret of a client.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - id: The client ID
+//   - secret: The API secret
+//
+// Returns:
+//   - *domain.APIClient: The authenticated API client
+//   - error: An unauthorized EndpointError when the client does not exist or the secret is wrong,
+//     without telling which
+func (s *APIClientService) Authenticate(ctx context.Context, id, secret string) (*domain.APIClient, error) {
+	client, err := s.apiClientRepository.FindByID(ctx, id)
+	if errors.Is(err, errors.ErrAPIClientNotFound) {
+		return nil, errors.NewUnauthorized("invalid client credentials")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if client.SecretHash == "" || subtle.ConstantTimeCompare([]byte(client.SecretHash), []byte(hashAPISecret(secret))) != 1 {
+		return nil, errors.NewUnauthorized("invalid client credentials")
+	}
+	return client, nil
+}
+
+// CheckAccess verifies that a client is enabled and calls from one of its allowed IPs.
+// Parameters:
+//   - client: The API client making the request
+//   - remoteAddr: The IP address of the caller, with or without a port
+//
+// Returns:
+//   - error: A forbidden EndpointError when the client may not make the request
+func (s *APIClientService) CheckAccess(client *domain.APIClient, remoteAddr string) error {
+	if !client.Enabled {
+		return errors.NewForbidden("API client " + client.ID + " is disabled")
+	}
+	if len(client.AllowedIPs) == 0 {
+		return nil
+	}
+	ip, err := parseRemoteIP(remoteAddr)
+	if err != nil {
+		return errors.NewForbidden("unable to determine the caller IP address")
+	}
+	for _, allowed := range client.AllowedIPs {
+		if prefix, err := netip.ParsePrefix(allowed); err == nil && prefix.Contains(ip) {
+			return nil
+		}
+		if addr, err := netip.ParseAddr(allowed); err == nil && addr.Unmap() == ip {
+			return nil
+		}
+	}
+	return errors.NewForbidden("API client " + client.ID + " may not call from " + ip.String())
+}
+
+// ToInfo converts an API client into its response model, leaving out its secrets.
+func (s *APIClientService) ToInfo(client *domain.APIClient) *model.APIClientInfo {
+	return &model.APIClientInfo{
+		ID:              client.ID,
+		Name:            client.Name,
+		TenantID:        client.TenantID,
+		PublicKey:       client.PublicKey,
+		HasClientSecret: client.ClientSecret != "",
+		Scopes:          client.Scopes,
+		AllowedIPs:      client.AllowedIPs,
+		Enabled:         client.Enabled,
+		RateLimit:       client.RateLimit,
+		CreatedAt:       client.CreatedAt,
+	}
+}
+
+// checkTenant verifies that the tenant a client is bound to exists. Clients bound to no tenant are valid.
+func (s *APIClientService) checkTenant(ctx context.Context, tenantID string) error {
+	if tenantID == "" {
+		return nil
+	}
+	if _, err := s.tenantRepository.FindByID(ctx, tenantID); err != nil {
+		if errors.Is(err, errors.ErrTenantNotFound) {
+			return errors.NewValidationErrorf("unknown tenant %v", tenantID)
+		}
+		return err
+	}
+	return nil
+}
+
+func validateAPIClientAccess(publicKey string, scopes, allowedIPs []string) error {
+	if publicKey != "" {
+		if _, err := signature.ParsePublicKey(publicKey); err != nil {
+			return errors.NewValidationErrorf("invalid public key: %v", err)
+		}
+	}
+	for _, scope := range scopes {
+		if _, ok := auth.Scopes[scope]; !ok {
+			return errors.NewValidationErrorf("unknown scope %v", scope)
+		}
+	}
+	for _, allowed := range allowedIPs {
+		if _, err := netip.ParsePrefix(allowed); err == nil {
+			continue
+		}
+		if _, err := netip.ParseAddr(allowed); err != nil {
+			return errors.NewValidationErrorf("%v is not an IP address or CIDR range", allowed)
+		}
+	}
+	return nil
+}
+
+// newAPISecret generates a random 256-bit API secret.
+func newAPISecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// hashAPISecret hashes an API secret. A fast hash is enough since the secrets are random and long,
+// unlike passwords.
+func hashAPISecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+func parseRemoteIP(remoteAddr string) (netip.Addr, error) {
+	if addrPort, err := netip.ParseAddrPort(remoteAddr); err == nil {
+		return addrPort.Addr().Unmap(), nil
+	}
+	addr, err := netip.ParseAddr(strings.Trim(remoteAddr, "[]"))
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	return addr.Unmap(), nil
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	pkgErrors "github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/model"
+	mockRepo "github.com/mrth1995/go-mockva/pkg/repository/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAPIClientService_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	apiClientRepo := mockRepo.NewMockAPIClientRepository(ctrl)
+	var saved *domain.APIClient
+	apiClientRepo.EXPECT().FindByID(ctx, "client-001").Return(nil, pkgErrors.NewAPIClientNotFound("client-001"))
+	apiClientRepo.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, client *domain.APIClient) error {
+		saved = client
+		return nil
+	})
+
+	apiClientService := NewAPIClientService(apiClientRepo, nil)
+	client, secret, err := apiClientService.Create(ctx, &model.APIClientRegister{
+		ID:         "client-001",
+		Name:       "Team A",
+		Scopes:     []string{auth.ScopeAccountsRead},
+		AllowedIPs: []string{"10.0.0.0/8"},
+		RateLimit:  60,
+	})
+
+	assertions := require.New(t)
+	assertions.Nil(err)
+	assertions.NotEmpty(secret)
+	assertions.Same(saved, client)
+	assertions.True(client.Enabled, "clients are enabled by default")
+	assertions.Equal(hashAPISecret(secret), client.SecretHash)
+	assertions.Empty(apiClientService.ToInfo(client).APISecret)
+
+	apiClientRepo.EXPECT().FindByID(ctx, "client-001").Return(client, nil).Times(2)
+	authenticated, err := apiClientService.Authenticate(ctx, "client-001", secret)
+	assertions.Nil(err)
+	assertions.Equal("client-001", authenticated.ID)
+	_, err = apiClientService.Authenticate(ctx, "client-001", "wrong")
+	assertions.True(pkgErrors.Is(err, pkgErrors.ErrUnauthorized))
+}
+
+func TestAPIClientService_Create_AlreadyExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	apiClientRepo := mockRepo.NewMockAPIClientRepository(ctrl)
+	apiClientRepo.EXPECT().FindByID(ctx, "client-001").Return(&domain.APIClient{ID: "client-001"}, nil)
+
+	_, _, err := NewAPIClientService(apiClientRepo, nil).Create(ctx, &model.APIClientRegister{ID: "client-001", Name: "Team A"})
+	require.True(t, pkgErrors.Is(err, pkgErrors.ErrConflict))
+}
+
+func TestAPIClientService_Edit_InvalidIP(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	apiClientRepo := mockRepo.NewMockAPIClientRepository(ctrl)
+	apiClientRepo.EXPECT().FindByID(ctx, "client-001").Return(&domain.APIClient{ID: "client-001", Enabled: true}, nil)
+
+	allowedIPs := []string{"10.0.0.300"}
+	_, err := NewAPIClientService(apiClientRepo, nil).Edit(ctx, "client-001", &model.APIClientEdit{AllowedIPs: &allowedIPs})
+	require.True(t, pkgErrors.Is(err, pkgErrors.ErrValidation))
+}
+
+func TestAPIClientService_RotateSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	client := &domain.APIClient{ID: "client-001", SecretHash: hashAPISecret("old"), Enabled: true}
+	apiClientRepo := mockRepo.NewMockAPIClientRepository(ctrl)
+	apiClientRepo.EXPECT().FindByID(ctx, "client-001").Return(client, nil).AnyTimes()
+	apiClientRepo.EXPECT().Save(ctx, client).Return(nil)
+
+	apiClientService := NewAPIClientService(apiClientRepo, nil)
+	_, secret, err := apiClientService.RotateSecret(ctx, "client-001")
+
+	assertions := require.New(t)
+	assertions.Nil(err)
+	_, err = apiClientService.Authenticate(ctx, "client-001", "old")
+	assertions.True(pkgErrors.Is(err, pkgErrors.ErrUnauthorized), "the previous secret stops working")
+	_, err = apiClientService.Authenticate(ctx, "client-001", secret)
+	assertions.Nil(err)
+}
+
+func TestAPIClientService_CheckAccess(t *testing.T) {
+	testCases := []struct {
+		name       string
+		enabled    bool
+		allowedIPs []string
+		remoteAddr string
+		allowed    bool
+	}{
+		{name: "Any IP", enabled: true, remoteAddr: "203.0.113.7:51234", allowed: true},
+		{name: "Allowed range", enabled: true, allowedIPs: []string{"10.0.0.0/8"}, remoteAddr: "10.1.2.3:51234", allowed: true},
+		{name: "Allowed address", enabled: true, allowedIPs: []string{"2001:db8::1"}, remoteAddr: "[2001:db8::1]:51234", allowed: true},
+		{name: "IPv4-mapped address", enabled: true, allowedIPs: []string{"10.1.2.3"}, remoteAddr: "[::ffff:10.1.2.3]:51234", allowed: true},
+		{name: "Other IP", enabled: true, allowedIPs: []string{"10.0.0.0/8"}, remoteAddr: "203.0.113.7:51234"},
+		{name: "Disabled", remoteAddr: "10.1.2.3:51234"},
+	}
+	apiClientService := NewAPIClientService(nil, nil)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := apiClientService.CheckAccess(&domain.APIClient{ID: "client-001", Enabled: tc.enabled, AllowedIPs: tc.allowedIPs}, tc.remoteAddr)
+			if tc.allowed {
+				require.Nil(t, err)
+				return
+			}
+			require.True(t, pkgErrors.Is(err, pkgErrors.ErrForbidden))
+		})
+	}
+}
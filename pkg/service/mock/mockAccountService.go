@@ -59,18 +59,18 @@ func (mr *MockAccountServiceMockRecorder) Edit(ctx, id, edit any) *gomock.Call {
 }
 
 // FindAndLockAccountBalance mocks base method.
-func (m *MockAccountService) FindAndLockAccountBalance(ctx context.Context, accountID string) (*domain.AccountBalance, error) {
+func (m *MockAccountService) FindAndLockAccountBalance(ctx context.Context, accountID string, tx *gorm.DB) (*domain.AccountBalance, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAndLockAccountBalance", ctx, accountID)
+	ret := m.ctrl.Call(m, "FindAndLockAccountBalance", ctx, accountID, tx)
 	ret0, _ := ret[0].(*domain.AccountBalance)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindAndLockAccountBalance indicates an expected call of FindAndLockAccountBalance.
-func (mr *MockAccountServiceMockRecorder) FindAndLockAccountBalance(ctx, accountID any) *gomock.Call {
+func (mr *MockAccountServiceMockRecorder) FindAndLockAccountBalance(ctx, accountID, tx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAndLockAccountBalance", reflect.TypeOf((*MockAccountService)(nil).FindAndLockAccountBalance), ctx, accountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAndLockAccountBalance", reflect.TypeOf((*MockAccountService)(nil).FindAndLockAccountBalance), ctx, accountID, tx)
 }
 
 // FindByID mocks base method.
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const settlementBatchSize = 100
+
+// SettlementWorker periodically settles PENDING transfers once they have been waiting
+// for the configured settlement delay.
+type SettlementWorker struct {
+	accountTrxService *AccountTransactionService
+	delay             time.Duration
+	interval          time.Duration
+
+	mu sync.Mutex
+	// quit ends the loop of the worker, abort cancels the settlement round in progress.
+	quit    chan struct{}
+	abort   context.CancelFunc
+	stopped chan struct{}
+}
+
+// NewSettlementWorker creates a new SettlementWorker.
+// Parameters:
+//   - accountTrxService: Service used to settle the pending transactions
+//   - delay: How long a transaction stays PENDING before it is settled
+//   - interval: How often the worker looks for pending transactions
+//
+// Returns:
+//   - *SettlementWorker: A worker that is not yet running
+func NewSettlementWorker(accountTrxService *AccountTransactionService, delay, interval time.Duration) *SettlementWorker {
+	return &SettlementWorker{
+		accountTrxService: accountTrxService,
+		delay:             delay,
+		interval:          interval,
+	}
+}
+
+// Start runs the worker in the background until Stop is called.
+func (w *SettlementWorker) Start() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.quit != nil {
+		return
+	}
+	ctx, abort := context.WithCancel(context.Background())
+	w.quit, w.abort = make(chan struct{}), abort
+	w.stopped = make(chan struct{})
+	go w.run(ctx, w.quit, w.stopped)
+	logrus.Infof("Settlement worker started, delay %v, interval %v", w.delay, w.interval)
+}
+
+// Stop signals the worker to stop and waits until the current settlement round finishes
+// or ctx is done. The round is only cancelled, rolling back the transaction it is settling,
+// once ctx is done.
+func (w *SettlementWorker) Stop(ctx context.Context) error {
+	w.mu.Lock()
+	quit, abort, stopped := w.quit, w.abort, w.stopped
+	w.quit, w.abort = nil, nil
+	w.mu.Unlock()
+	if quit == nil {
+		return nil
+	}
+	close(quit)
+	select {
+	case <-stopped:
+		abort()
+		logrus.Info("Settlement worker stopped")
+		return nil
+	case <-ctx.Done():
+		abort()
+		return ctx.Err()
+	}
+}
+
+// Running reports whether the worker has been started and not stopped.
+func (w *SettlementWorker) Running() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.quit == nil {
+		return false
+	}
+	select {
+	case <-w.stopped:
+		return false
+	default:
+		return true
+	}
+}
+
+// run settles the pending transactions on every tick until quit is closed. The rounds run on ctx,
+// which Stop does not cancel while they may still finish in time.
+func (w *SettlementWorker) run(ctx context.Context, quit, stopped chan struct{}) {
+	defer close(stopped)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-quit:
+			return
+		case <-ticker.C:
+			select {
+			case <-quit:
+				// Both were ready, do not start another round once stopping
+				return
+			default:
+			}
+			settled, err := w.accountTrxService.SettlePending(ctx, w.delay, settlementBatchSize)
+			if err != nil {
+				logrus.Errorf("unable to settle pending transactions: %v", err)
+				continue
+			}
+			if settled > 0 {
+				logrus.Infof("Settled %d pending transactions", settled)
+			}
+		}
+	}
+}
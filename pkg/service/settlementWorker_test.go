@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	mockRepo "github.com/mrth1995/go-mockva/pkg/repository/mock"
+	mockService "github.com/mrth1995/go-mockva/pkg/service/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+// newBlockingSettlementWorker returns a worker whose first settlement round runs settle in its
+// database transaction, and reports when that round has started.
+func newBlockingSettlementWorker(ctrl *gomock.Controller, settle func(ctx context.Context) error) (*SettlementWorker, chan struct{}) {
+	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
+	txManager := mockRepo.NewMockDBTransactionManager(ctrl)
+	pending := getPendingTransaction(getAccountSrc().ID, getAccountDst().ID, 100_000)
+
+	var rounds atomic.Int32
+	accountTrxRepo.EXPECT().FindPendingBefore(gomock.Any(), gomock.Any(), settlementBatchSize).
+		DoAndReturn(func(_ context.Context, _ time.Time, _ int) ([]domain.AccountTransaction, error) {
+			if rounds.Add(1) > 1 {
+				return nil, nil
+			}
+			return []domain.AccountTransaction{*pending}, nil
+		}).AnyTimes()
+	roundStarted := make(chan struct{})
+	txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, _ func(tx *gorm.DB) error) error {
+		close(roundStarted)
+		return settle(ctx)
+	})
+
+	accountTrxService := NewAccountTrxService(mockService.NewMockAccountService(ctrl), accountTrxRepo, txManager, WithAsyncTransfer(true))
+	return NewSettlementWorker(accountTrxService, 0, 10*time.Millisecond), roundStarted
+}
+
+func TestSettlementWorker_StopWaitsForRound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	release := make(chan struct{})
+	var committed atomic.Bool
+	worker, roundStarted := newBlockingSettlementWorker(ctrl, func(ctx context.Context) error {
+		<-release
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		committed.Store(true)
+		return nil
+	})
+	worker.Start()
+	<-roundStarted
+
+	stopErr := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		stopErr <- worker.Stop(ctx)
+	}()
+	assertions := require.New(t)
+	assertions.Eventually(func() bool { return !worker.Running() }, time.Second, time.Millisecond)
+	close(release)
+
+	assertions.Nil(<-stopErr)
+	assertions.True(committed.Load(), "The round in progress should commit")
+}
+
+func TestSettlementWorker_StopTimeoutAbortsRound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	aborted := make(chan struct{})
+	worker, roundStarted := newBlockingSettlementWorker(ctrl, func(ctx context.Context) error {
+		<-ctx.Done()
+		close(aborted)
+		return ctx.Err()
+	})
+	worker.Start()
+	<-roundStarted
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assertions := require.New(t)
+	assertions.ErrorIs(worker.Stop(ctx), context.DeadlineExceeded)
+	select {
+	case <-aborted:
+	case <-time.After(time.Second):
+		assertions.Fail("The round should be cancelled once the shutdown timeout expires")
+	}
+}
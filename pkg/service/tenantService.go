@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	"github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/model"
+	"github.com/mrth1995/go-mockva/pkg/repository"
+	"github.com/mrth1995/go-mockva/pkg/tenant"
+)
+
+// TenantService provisions and wipes the tenants isolating the data of the teams sharing mockva.
+type TenantService struct {
+	tenantRepository repository.TenantRepository
+}
+
+// NewTenantService creates a new TenantService.
+func NewTenantService(tenantRepo repository.TenantRepository) *TenantService {
+	return &TenantService{tenantRepository: tenantRepo}
+}
+
+// FindByID retrieves a tenant by its tenant ID.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - id: The tenant ID
+//
+// Returns:
+//   - *domain.Tenant: The tenant if found
+//   - error: If the tenant is not found or a database error occurs
+func (s *TenantService) FindByID(ctx context.Context, id string) (*domain.Tenant, error) {
+	return s.tenantRepository.FindByID(ctx, id)
+}
+
+// FindAll retrieves every tenant ordered by tenant ID.
+func (s *TenantService) FindAll(ctx context.Context) ([]domain.Tenant, error) {
+	return s.tenantRepository.FindAll(ctx)
+}
+
+// Create provisions a tenant with its own settlement accounts.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - register: The tenant ID and name
+//
+// Returns:
+//   - *domain.Tenant: The provisioned tenant
+//   - error: If the tenant already exists or a database error occurs
+func (s *TenantService) Create(ctx context.Context, register *model.TenantRegister) (*domain.Tenant, error) {
+	_, err := s.tenantRepository.FindByID(ctx, register.ID)
+	if err == nil {
+		return nil, errors.NewConflict("Tenant " + register.ID + " already exist")
+	}
+	if !errors.Is(err, errors.ErrTenantNotFound) {
+		return nil, err
+	}
+	newTenant := &domain.Tenant{
+		ID:        register.ID,
+		Name:      register.Name,
+		CreatedAt: time.Now(),
+	}
+	if err = s.tenantRepository.Create(ctx, newTenant); err != nil {
+		return nil, err
+	}
+	return newTenant, nil
+}
+
+// Wipe deletes every account, transaction and virtual account of a tenant, leaving it as it was
+// just after being provisioned.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - id: The tenant ID
+//
+// Returns:
+//   - error: If the tenant is not found or a database error occurs
+func (s *TenantService) Wipe(ctx context.Context, id string) error {
+	if _, err := s.tenantRepository.FindByID(ctx, id); err != nil {
+		return err
+	}
+	return s.tenantRepository.Wipe(ctx, id)
+}
+
+// Resolve picks the tenant of a request made by clientTenantID's client naming requested in its
+// X-TENANT-ID header. A client bound to a tenant may only operate on it, other clients and anonymous
+// requests operate on the requested tenant, or the default one when none is requested.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - clientTenantID: The tenant the calling client is bound to, empty when it is not bound to one
+//   - requested: The tenant named by the request, empty when it names none
+//
+// Returns:
+//   - string: The tenant the request operates on
+//   - error: A forbidden EndpointError when the client may not operate on the requested tenant, or
+//     a not found one when the tenant does not exist
+func (s *TenantService) Resolve(ctx context.Context, clientTenantID, requested string) (string, error) {
+	if clientTenantID != "" {
+		if requested != "" && requested != clientTenantID {
+			return "", errors.NewForbidden("API client may only operate on tenant " + clientTenantID)
+		}
+		return clientTenantID, nil
+	}
+	if requested == "" || requested == tenant.DefaultID {
+		return tenant.DefaultID, nil
+	}
+	if _, err := s.tenantRepository.FindByID(ctx, requested); err != nil {
+		return "", err
+	}
+	return requested, nil
+}
+
+// ToInfo converts a tenant into its response model.
+func (s *TenantService) ToInfo(t *domain.Tenant) *model.TenantInfo {
+	return &model.TenantInfo{
+		ID:        t.ID,
+		Name:      t.Name,
+		CreatedAt: t.CreatedAt,
+	}
+}
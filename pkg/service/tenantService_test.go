@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	pkgErrors "github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/model"
+	mockRepo "github.com/mrth1995/go-mockva/pkg/repository/mock"
+	"github.com/mrth1995/go-mockva/pkg/tenant"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTenantService_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	tenantRepo := mockRepo.NewMockTenantRepository(ctrl)
+	tenantRepo.EXPECT().FindByID(ctx, "team-a").Return(nil, pkgErrors.NewTenantNotFound("team-a"))
+	tenantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+	created, err := NewTenantService(tenantRepo).Create(ctx, &model.TenantRegister{ID: "team-a", Name: "Team A"})
+
+	assertions := require.New(t)
+	assertions.Nil(err)
+	assertions.Equal("team-a", created.ID)
+	assertions.False(created.CreatedAt.IsZero())
+}
+
+func TestTenantService_Create_AlreadyExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	tenantRepo := mockRepo.NewMockTenantRepository(ctrl)
+	tenantRepo.EXPECT().FindByID(ctx, "team-a").Return(&domain.Tenant{ID: "team-a"}, nil)
+
+	_, err := NewTenantService(tenantRepo).Create(ctx, &model.TenantRegister{ID: "team-a", Name: "Team A"})
+	require.True(t, pkgErrors.Is(err, pkgErrors.ErrConflict))
+}
+
+func TestTenantService_Wipe_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	tenantRepo := mockRepo.NewMockTenantRepository(ctrl)
+	tenantRepo.EXPECT().FindByID(ctx, "team-a").Return(nil, pkgErrors.NewTenantNotFound("team-a"))
+
+	err := NewTenantService(tenantRepo).Wipe(ctx, "team-a")
+	require.True(t, pkgErrors.Is(err, pkgErrors.ErrTenantNotFound))
+}
+
+func TestTenantService_Resolve(t *testing.T) {
+	testCases := []struct {
+		name           string
+		clientTenantID string
+		requested      string
+		expectedTenant string
+		expectedErr    error
+	}{
+		{name: "Default", expectedTenant: tenant.DefaultID},
+		{name: "Requested", requested: "team-a", expectedTenant: "team-a"},
+		{name: "Unknown", requested: "team-x", expectedErr: pkgErrors.ErrTenantNotFound},
+		{name: "Bound client", clientTenantID: "team-a", expectedTenant: "team-a"},
+		{name: "Bound client naming its tenant", clientTenantID: "team-a", requested: "team-a", expectedTenant: "team-a"},
+		{name: "Bound client naming another tenant", clientTenantID: "team-a", requested: "team-b", expectedErr: pkgErrors.ErrForbidden},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			tenantRepo := mockRepo.NewMockTenantRepository(ctrl)
+			tenantRepo.EXPECT().FindByID(ctx, "team-a").Return(&domain.Tenant{ID: "team-a"}, nil).AnyTimes()
+			tenantRepo.EXPECT().FindByID(ctx, "team-x").Return(nil, pkgErrors.NewTenantNotFound("team-x")).AnyTimes()
+
+			tenantID, err := NewTenantService(tenantRepo).Resolve(ctx, tc.clientTenantID, tc.requested)
+
+			assertions := require.New(t)
+			if tc.expectedErr != nil {
+				assertions.True(pkgErrors.Is(err, tc.expectedErr), "unexpected error %v", err)
+				return
+			}
+			assertions.Nil(err)
+			assertions.Equal(tc.expectedTenant, tenantID)
+		})
+	}
+}
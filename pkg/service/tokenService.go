@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	"github.com/mrth1995/go-mockva/pkg/errors"
+)
+
+// TokenService issues bearer tokens to API clients and authenticates the requests carrying them.
+type TokenService struct {
+	apiClientService *APIClientService
+	tokenIssuer      *auth.TokenIssuer
+}
+
+// NewTokenService creates a new TokenService.
+// Parameters:
+//   - apiClientService: Service providing the credentials and scopes of the API clients
+//   - tokenIssuer: Signs and validates the tokens
+//
+// Returns:
+//   - *TokenService: A ready to use TokenService
+func NewTokenService(apiClientService *APIClientService, tokenIssuer *auth.TokenIssuer) *TokenService {
+	return &TokenService{
+		apiClientService: apiClientService,
+		tokenIssuer:      tokenIssuer,
+	}
+}
+
+// ClientCredentials issues tokens to a client authenticated with its API secret.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - clientID: The client ID
+//   - apiSecret: The API secret of the client
+//   - scopes: The requested scopes, or none to be granted every scope of the client
+//
+// Returns:
+//   - *auth.Token: The access and refresh tokens
+//   - error: An auth.OAuthError when the client cannot be authenticated, is disabled or a scope is not allowed
+func (s *TokenService) ClientCredentials(ctx context.Context, clientID, apiSecret string, scopes []string) (*auth.Token, error) {
+	if clientID == "" || apiSecret == "" {
+		return nil, auth.NewOAuthError(auth.OAuthInvalidClient, "client authentication is required")
+	}
+	client, err := s.apiClientService.Authenticate(ctx, clientID, apiSecret)
+	if errors.Is(err, errors.ErrUnauthorized) {
+		return nil, auth.NewOAuthError(auth.OAuthInvalidClient, "invalid client credentials")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !client.Enabled {
+		return nil, auth.NewOAuthError(auth.OAuthInvalidClient, "client is disabled")
+	}
+	return s.issue(client.ID, client.Scopes, scopes)
+}
+
+// IssueForClient issues tokens to a client that was already authenticated, e.g. by the
+// signature of a SNAP token request.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - clientID: The client ID
+//
+// Returns:
+//   - *auth.Token: The access and refresh tokens, granting every scope of the client
+//   - error: An auth.OAuthError when the client does not exist
+func (s *TokenService) IssueForClient(ctx context.Context, clientID string) (*auth.Token, error) {
+	client, err := s.apiClientService.FindByID(ctx, clientID)
+	if errors.Is(err, errors.ErrAPIClientNotFound) {
+		return nil, auth.NewOAuthError(auth.OAuthInvalidClient, "unknown client")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !client.Enabled {
+		return nil, auth.NewOAuthError(auth.OAuthInvalidClient, "client is disabled")
+	}
+	return s.issue(client.ID, client.Scopes, nil)
+}
+
+// Refresh exchanges a refresh token for new tokens. The client's current scopes still apply.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - refreshToken: The refresh token
+//   - scopes: The requested scopes, or none to keep the scopes of the refresh token the client still has
+//
+// Returns:
+//   - *auth.Token: The new access and refresh tokens
+//   - error: An auth.OAuthError when the refresh token is invalid or expired, or a scope is not allowed
+func (s *TokenService) Refresh(ctx context.Context, refreshToken string, scopes []string) (*auth.Token, error) {
+	principal, err := s.tokenIssuer.Parse(refreshToken, auth.TokenTypeRefresh)
+	if err != nil {
+		return nil, auth.NewOAuthError(auth.OAuthInvalidGrant, err.Error())
+	}
+	client, err := s.apiClientService.FindByID(ctx, principal.ClientID)
+	if errors.Is(err, errors.ErrAPIClientNotFound) {
+		return nil, auth.NewOAuthError(auth.OAuthInvalidGrant, "the client of the refresh token no longer exists")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !client.Enabled {
+		return nil, auth.NewOAuthError(auth.OAuthInvalidGrant, "the client of the refresh token is disabled")
+	}
+	allowed := slices.DeleteFunc(slices.Clone(principal.Scopes), func(scope string) bool {
+		return !slices.Contains(client.Scopes, scope)
+	})
+	return s.issue(client.ID, allowed, scopes)
+}
+
+// Authenticate validates an access token.
+// Parameters:
+//   - accessToken: The bearer token of the request
+//
+// Returns:
+//   - *auth.Principal: The client the token was issued to and its granted scopes
+//   - error: An unauthorized EndpointError when the token is invalid or expired
+func (s *TokenService) Authenticate(accessToken string) (*auth.Principal, error) {
+	principal, err := s.tokenIssuer.Parse(accessToken, auth.TokenTypeAccess)
+	if err != nil {
+		return nil, errors.NewUnauthorized(err.Error())
+	}
+	return principal, nil
+}
+
+// issue grants the requested scopes, or every allowed scope when none are requested.
+func (s *TokenService) issue(clientID string, allowed, requested []string) (*auth.Token, error) {
+	if len(requested) == 0 {
+		requested = allowed
+	}
+	for _, scope := range requested {
+		if !slices.Contains(allowed, scope) {
+			return nil, auth.NewOAuthError(auth.OAuthInvalidScope, "scope "+scope+" is not allowed")
+		}
+	}
+	return s.tokenIssuer.Issue(clientID, requested)
+}
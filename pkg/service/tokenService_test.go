@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mrth1995/go-mockva/pkg/auth"
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	pkgErrors "github.com/mrth1995/go-mockva/pkg/errors"
+	mockRepo "github.com/mrth1995/go-mockva/pkg/repository/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTokenService_ClientCredentials(t *testing.T) {
+	testCases := []struct {
+		name         string
+		clientID     string
+		clientSecret string
+		scopes       []string
+		expectedCode string
+		expected     []string
+	}{
+		{name: "All scopes of the client", clientID: "client-001", clientSecret: "secret", expected: []string{auth.ScopeAccountsRead, auth.ScopeTransfersWrite}},
+		{name: "Requested scopes", clientID: "client-001", clientSecret: "secret", scopes: []string{auth.ScopeAccountsRead}, expected: []string{auth.ScopeAccountsRead}},
+		{name: "Scope not allowed", clientID: "client-001", clientSecret: "secret", scopes: []string{auth.ScopeAccountsWrite}, expectedCode: auth.OAuthInvalidScope},
+		{name: "Wrong secret", clientID: "client-001", clientSecret: "other", expectedCode: auth.OAuthInvalidClient},
+		{name: "Unknown client", clientID: "client-002", clientSecret: "secret", expectedCode: auth.OAuthInvalidClient},
+		{name: "Missing secret", clientID: "client-001", expectedCode: auth.OAuthInvalidClient},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tokenService := newTestTokenService(t, ctrl, time.Minute, []string{auth.ScopeAccountsRead, auth.ScopeTransfersWrite})
+			token, err := tokenService.ClientCredentials(context.Background(), tc.clientID, tc.clientSecret, tc.scopes)
+
+			assertions := require.New(t)
+			if tc.expectedCode != "" {
+				var oauthErr *auth.OAuthError
+				assertions.ErrorAs(err, &oauthErr)
+				assertions.Equal(tc.expectedCode, oauthErr.Code)
+				return
+			}
+			assertions.Nil(err)
+			assertions.Equal(tc.expected, token.Scopes)
+
+			principal, err := tokenService.Authenticate(token.AccessToken)
+			assertions.Nil(err)
+			assertions.Equal("client-001", principal.ClientID)
+			assertions.Equal(tc.expected, principal.Scopes)
+		})
+	}
+}
+
+func TestTokenService_Refresh_AfterAccessTokenExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	tokenService := newTestTokenService(t, ctrl, -time.Minute, []string{auth.ScopeAccountsRead})
+	token, err := tokenService.ClientCredentials(ctx, "client-001", "secret", nil)
+
+	assertions := require.New(t)
+	assertions.Nil(err)
+	_, err = tokenService.Authenticate(token.AccessToken)
+	assertions.True(pkgErrors.Is(err, pkgErrors.ErrUnauthorized), "the access token is already expired")
+
+	refreshed, err := tokenService.Refresh(ctx, token.RefreshToken, nil)
+	assertions.Nil(err)
+	assertions.Equal([]string{auth.ScopeAccountsRead}, refreshed.Scopes)
+	assertions.NotEqual(token.RefreshToken, refreshed.RefreshToken)
+}
+
+func TestTokenService_Refresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	key, err := auth.LoadSigningKey("")
+	require.NoError(t, err)
+	tokenIssuer, err := auth.NewTokenIssuer(key, time.Minute, time.Hour)
+	require.NoError(t, err)
+	apiClientRepo := mockRepo.NewMockAPIClientRepository(ctrl)
+	apiClientRepo.EXPECT().FindByID(ctx, "client-001").
+		Return(&domain.APIClient{ID: "client-001", Enabled: true, Scopes: []string{auth.ScopeAccountsRead}}, nil).AnyTimes()
+	tokenService := NewTokenService(NewAPIClientService(apiClientRepo, nil), tokenIssuer)
+
+	token, err := tokenIssuer.Issue("client-001", []string{auth.ScopeAccountsRead, auth.ScopeAccountsWrite})
+	require.NoError(t, err)
+
+	assertions := require.New(t)
+	refreshed, err := tokenService.Refresh(ctx, token.RefreshToken, nil)
+	assertions.Nil(err)
+	assertions.Equal([]string{auth.ScopeAccountsRead}, refreshed.Scopes, "scopes the client lost since are dropped")
+
+	_, err = tokenService.Refresh(ctx, token.RefreshToken, []string{auth.ScopeAccountsWrite})
+	var oauthErr *auth.OAuthError
+	assertions.ErrorAs(err, &oauthErr)
+	assertions.Equal(auth.OAuthInvalidScope, oauthErr.Code)
+
+	_, err = tokenService.Refresh(ctx, token.AccessToken, nil)
+	assertions.ErrorAs(err, &oauthErr)
+	assertions.Equal(auth.OAuthInvalidGrant, oauthErr.Code, "an access token is not a refresh token")
+}
+
+func TestTokenService_DisabledClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	key, err := auth.LoadSigningKey("")
+	require.NoError(t, err)
+	tokenIssuer, err := auth.NewTokenIssuer(key, time.Minute, time.Hour)
+	require.NoError(t, err)
+	apiClientRepo := mockRepo.NewMockAPIClientRepository(ctrl)
+	apiClientRepo.EXPECT().FindByID(ctx, "client-001").
+		Return(&domain.APIClient{ID: "client-001", SecretHash: hashAPISecret("secret"), Scopes: []string{auth.ScopeAccountsRead}}, nil).AnyTimes()
+	tokenService := NewTokenService(NewAPIClientService(apiClientRepo, nil), tokenIssuer)
+
+	assertions := require.New(t)
+	var oauthErr *auth.OAuthError
+	_, err = tokenService.ClientCredentials(ctx, "client-001", "secret", nil)
+	assertions.ErrorAs(err, &oauthErr)
+	assertions.Equal(auth.OAuthInvalidClient, oauthErr.Code)
+
+	token, err := tokenIssuer.Issue("client-001", []string{auth.ScopeAccountsRead})
+	assertions.NoError(err)
+	_, err = tokenService.Refresh(ctx, token.RefreshToken, nil)
+	assertions.ErrorAs(err, &oauthErr)
+	assertions.Equal(auth.OAuthInvalidGrant, oauthErr.Code, "a disabled client cannot refresh its tokens")
+}
+
+// newTestTokenService returns a TokenService whose only client is client-001 with API secret "secret".
+func newTestTokenService(t *testing.T, ctrl *gomock.Controller, accessTTL time.Duration, scopes []string) *TokenService {
+	key, err := auth.LoadSigningKey("")
+	require.NoError(t, err)
+	tokenIssuer, err := auth.NewTokenIssuer(key, accessTTL, time.Hour)
+	require.NoError(t, err)
+
+	apiClientRepo := mockRepo.NewMockAPIClientRepository(ctrl)
+	apiClientRepo.EXPECT().FindByID(gomock.Any(), "client-001").
+		Return(&domain.APIClient{ID: "client-001", SecretHash: hashAPISecret("secret"), Enabled: true, Scopes: scopes}, nil).AnyTimes()
+	apiClientRepo.EXPECT().FindByID(gomock.Any(), gomock.Any()).
+		Return(nil, pkgErrors.NewAPIClientNotFound("client-002")).AnyTimes()
+	return NewTokenService(NewAPIClientService(apiClientRepo, nil), tokenIssuer)
+}
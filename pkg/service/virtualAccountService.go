@@ -0,0 +1,376 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	"github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/idgen"
+	"github.com/mrth1995/go-mockva/pkg/model"
+	"github.com/mrth1995/go-mockva/pkg/repository"
+	"gorm.io/gorm"
+)
+
+const (
+	// virtualAccountChannel is recorded as the channel of the deposits made by virtual account payments.
+	virtualAccountChannel     = "SNAP"
+	defaultCurrency           = "IDR"
+	maxPartnerServiceIDLength = 8
+	maxPaymentRequestIDLength = 64
+)
+
+// VirtualAccountService manages virtual account bills. Paying a bill deposits the paid
+// amount into the account whose ID equals the bill's customer number.
+type VirtualAccountService struct {
+	accountService           AccountService
+	accountTrxService        *AccountTransactionService
+	virtualAccountRepository repository.VirtualAccountRepository
+	txManager                repository.DBTransactionManager
+	idGenerator              idgen.Generator
+}
+
+// NewVirtualAccountService creates a new VirtualAccountService.
+// Parameters:
+//   - accountService: Service used to resolve the account credited by a virtual account
+//   - accountTrxService: Service used to deposit the payments
+//   - virtualAccountRepo: Repository storing the virtual accounts
+//   - txManager: Manager for the database transactions of the payments
+//   - idGenerator: Generator of the trx IDs of bills created without one, or nil for random ULIDs
+//
+// Returns:
+//   - *VirtualAccountService: A ready to use VirtualAccountService
+func NewVirtualAccountService(accountService AccountService, accountTrxService *AccountTransactionService, virtualAccountRepo repository.VirtualAccountRepository,
+	txManager repository.DBTransactionManager, idGenerator idgen.Generator) *VirtualAccountService {
+	if idGenerator == nil {
+		idGenerator, _ = idgen.New(idgen.KindULID, 0)
+	}
+	return &VirtualAccountService{
+		accountService:           accountService,
+		accountTrxService:        accountTrxService,
+		virtualAccountRepository: virtualAccountRepo,
+		txManager:                txManager,
+		idGenerator:              idGenerator,
+	}
+}
+
+// Create registers a new virtual account bill. A deleted virtual account number can be registered again.
+// A bill registered without a trx ID gets one from the ID generator.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - register: The bill details
+//
+// Returns:
+//   - *domain.VirtualAccount: The created virtual account
+//   - error: If the details are invalid, the account does not exist or the virtual account already exists
+func (s *VirtualAccountService) Create(ctx context.Context, register *model.VirtualAccountRegister) (*domain.VirtualAccount, error) {
+	virtualAccountNo, err := validateVirtualAccountRegister(register)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.virtualAccountRepository.FindByNo(ctx, virtualAccountNo)
+	if err != nil && !errors.Is(err, errors.ErrVirtualAccountNotFound) {
+		return nil, err
+	}
+	if existing != nil && existing.Status != domain.VirtualAccountStatusDeleted {
+		return nil, errors.NewConflict("Virtual account " + virtualAccountNo + " already exist")
+	}
+	account, err := s.accountService.FindByID(ctx, strings.TrimSpace(register.CustomerNo))
+	if err != nil {
+		return nil, err
+	}
+
+	virtualAccount := &domain.VirtualAccount{
+		VirtualAccountNo: virtualAccountNo,
+		PartnerServiceID: strings.TrimSpace(register.PartnerServiceID),
+		CustomerNo:       strings.TrimSpace(register.CustomerNo),
+		AccountID:        account.ID,
+		Name:             account.Name,
+		Status:           domain.VirtualAccountStatusActive,
+		CreatedAt:        time.Now(),
+	}
+	if existing != nil {
+		virtualAccount.CreatedAt = existing.CreatedAt
+	}
+	applyVirtualAccountRegister(virtualAccount, register)
+	if virtualAccount.TrxID == "" {
+		virtualAccount.TrxID = s.idGenerator.NewID()
+	}
+	if err = s.virtualAccountRepository.Save(ctx, virtualAccount); err != nil {
+		return nil, err
+	}
+	return virtualAccount, nil
+}
+
+// Update replaces the bill details of an unpaid virtual account. The trx ID is kept when none is given.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - register: The new bill details
+//
+// Returns:
+//   - *domain.VirtualAccount: The updated virtual account
+//   - error: If the details are invalid, the virtual account does not exist or is already paid
+func (s *VirtualAccountService) Update(ctx context.Context, register *model.VirtualAccountRegister) (*domain.VirtualAccount, error) {
+	virtualAccountNo, err := validateVirtualAccountRegister(register)
+	if err != nil {
+		return nil, err
+	}
+	virtualAccount, err := s.findActive(ctx, virtualAccountNo)
+	if err != nil {
+		return nil, err
+	}
+	if virtualAccount.Status == domain.VirtualAccountStatusPaid {
+		return nil, errors.NewBillAlreadyPaid(virtualAccountNo)
+	}
+	applyVirtualAccountRegister(virtualAccount, register)
+	if err = s.virtualAccountRepository.Save(ctx, virtualAccount); err != nil {
+		return nil, err
+	}
+	return virtualAccount, nil
+}
+
+// Delete deactivates a virtual account so that it can no longer be inquired or paid.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - partnerServiceID: The partner service ID of the virtual account
+//   - customerNo: The customer number of the virtual account
+//
+// Returns:
+//   - *domain.VirtualAccount: The deleted virtual account
+//   - error: If the virtual account does not exist
+func (s *VirtualAccountService) Delete(ctx context.Context, partnerServiceID, customerNo string) (*domain.VirtualAccount, error) {
+	virtualAccount, err := s.findActive(ctx, domain.VirtualAccountNo(partnerServiceID, customerNo))
+	if err != nil {
+		return nil, err
+	}
+	virtualAccount.Status = domain.VirtualAccountStatusDeleted
+	virtualAccount.UpdatedAt = time.Now()
+	if err = s.virtualAccountRepository.Save(ctx, virtualAccount); err != nil {
+		return nil, err
+	}
+	return virtualAccount, nil
+}
+
+// Inquiry returns a virtual account bill that can be paid and records the inquiry request ID.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - partnerServiceID: The partner service ID of the virtual account
+//   - customerNo: The customer number of the virtual account
+//   - inquiryRequestID: The ID of the inquiry sent by the paying channel
+//
+// Returns:
+//   - *domain.VirtualAccount: The payable virtual account
+//   - error: If the virtual account does not exist, is expired or is already paid
+func (s *VirtualAccountService) Inquiry(ctx context.Context, partnerServiceID, customerNo, inquiryRequestID string) (*domain.VirtualAccount, error) {
+	virtualAccount, err := s.findPayable(ctx, domain.VirtualAccountNo(partnerServiceID, customerNo))
+	if err != nil {
+		return nil, err
+	}
+	virtualAccount.InquiryRequestID = inquiryRequestID
+	virtualAccount.UpdatedAt = time.Now()
+	if err = s.virtualAccountRepository.Save(ctx, virtualAccount); err != nil {
+		return nil, err
+	}
+	return virtualAccount, nil
+}
+
+// Pay deposits the paid amount into the account of the virtual account and marks the bill as paid.
+// The bill is locked, credited and marked as paid within one database transaction, so that
+// concurrent payments of the same bill wait for each other and a bill is never credited twice.
+// Retrying a payment with the same payment request ID returns the existing payment.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - payment: The payment details
+//
+// Returns:
+//   - *domain.VirtualAccount: The paid virtual account
+//   - error: If the payment is invalid or the bill cannot be paid
+func (s *VirtualAccountService) Pay(ctx context.Context, payment *model.VirtualAccountPayment) (*domain.VirtualAccount, error) {
+	virtualAccountNo := domain.VirtualAccountNo(payment.PartnerServiceID, payment.CustomerNo)
+	if err := validateVirtualAccountNo(virtualAccountNo, payment.VirtualAccountNo); err != nil {
+		return nil, err
+	}
+	if payment.PaymentRequestID == "" {
+		return nil, errors.NewValidationError("payment request ID cannot be empty")
+	}
+	if len(payment.PaymentRequestID) > maxPaymentRequestIDLength {
+		return nil, errors.NewValidationErrorf("payment request ID cannot be longer than %d characters", maxPaymentRequestIDLength)
+	}
+
+	var paidVirtualAccount *domain.VirtualAccount
+	err := s.txManager.Transaction(ctx, func(tx *gorm.DB) error {
+		virtualAccount, err := s.virtualAccountRepository.FindAndLockByNo(ctx, virtualAccountNo, tx)
+		if err != nil {
+			return err
+		}
+		if virtualAccount.Status == domain.VirtualAccountStatusDeleted {
+			return errors.NewVirtualAccountNotFound(virtualAccountNo)
+		}
+		if virtualAccount.Status == domain.VirtualAccountStatusPaid && virtualAccount.PaymentRequestID == payment.PaymentRequestID {
+			paidVirtualAccount = virtualAccount
+			return nil
+		}
+		if err = checkPayable(virtualAccount); err != nil {
+			return err
+		}
+		if err = validatePaidAmount(virtualAccount, payment); err != nil {
+			return err
+		}
+
+		trx, err := s.accountTrxService.DepositInTx(ctx, &model.AccountDeposit{
+			AccountID: virtualAccount.AccountID,
+			Amount:    payment.PaidAmount,
+			Channel:   virtualAccountChannel,
+			Reference: payment.PaymentRequestID,
+		}, tx)
+		if err != nil {
+			return err
+		}
+		virtualAccount.Status = domain.VirtualAccountStatusPaid
+		virtualAccount.PaymentRequestID = payment.PaymentRequestID
+		virtualAccount.PaymentTransactionID = trx.ID
+		virtualAccount.PaidAmount = payment.PaidAmount
+		virtualAccount.PaidAt = &trx.TransactionTimestamp
+		virtualAccount.UpdatedAt = time.Now()
+		if err = s.virtualAccountRepository.Update(ctx, virtualAccount, tx); err != nil {
+			return err
+		}
+		paidVirtualAccount = virtualAccount
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return paidVirtualAccount, nil
+}
+
+// FindPayment returns the virtual account paid with the given payment request ID.
+// Parameters:
+//   - ctx: The request context for cancellation and timeouts
+//   - partnerServiceID: The partner service ID of the virtual account
+//   - customerNo: The customer number of the virtual account
+//   - paymentRequestID: The ID of the payment, or empty to match any payment
+//
+// Returns:
+//   - *domain.VirtualAccount: The paid virtual account
+//   - error: If the virtual account does not exist or was not paid with the given payment request ID
+func (s *VirtualAccountService) FindPayment(ctx context.Context, partnerServiceID, customerNo, paymentRequestID string) (*domain.VirtualAccount, error) {
+	virtualAccount, err := s.virtualAccountRepository.FindByNo(ctx, domain.VirtualAccountNo(partnerServiceID, customerNo))
+	if err != nil {
+		return nil, err
+	}
+	if virtualAccount.PaymentTransactionID == "" ||
+		(paymentRequestID != "" && paymentRequestID != virtualAccount.PaymentRequestID) {
+		return nil, errors.NewTransactionNotFound(paymentRequestID)
+	}
+	return virtualAccount, nil
+}
+
+func (s *VirtualAccountService) findActive(ctx context.Context, virtualAccountNo string) (*domain.VirtualAccount, error) {
+	virtualAccount, err := s.virtualAccountRepository.FindByNo(ctx, virtualAccountNo)
+	if err != nil {
+		return nil, err
+	}
+	if virtualAccount.Status == domain.VirtualAccountStatusDeleted {
+		return nil, errors.NewVirtualAccountNotFound(virtualAccountNo)
+	}
+	return virtualAccount, nil
+}
+
+func (s *VirtualAccountService) findPayable(ctx context.Context, virtualAccountNo string) (*domain.VirtualAccount, error) {
+	virtualAccount, err := s.findActive(ctx, virtualAccountNo)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkPayable(virtualAccount); err != nil {
+		return nil, err
+	}
+	return virtualAccount, nil
+}
+
+func checkPayable(virtualAccount *domain.VirtualAccount) error {
+	if virtualAccount.Status == domain.VirtualAccountStatusPaid {
+		return errors.NewBillAlreadyPaid(virtualAccount.VirtualAccountNo)
+	}
+	if virtualAccount.IsExpired(time.Now()) {
+		return errors.NewVirtualAccountExpired(virtualAccount.VirtualAccountNo)
+	}
+	return nil
+}
+
+func validateVirtualAccountRegister(register *model.VirtualAccountRegister) (string, error) {
+	partnerServiceID := strings.TrimSpace(register.PartnerServiceID)
+	if partnerServiceID == "" {
+		return "", errors.NewValidationError("partner service ID cannot be empty")
+	}
+	if len(partnerServiceID) > maxPartnerServiceIDLength {
+		return "", errors.NewValidationErrorf("partner service ID cannot be longer than %d characters", maxPartnerServiceIDLength)
+	}
+	if strings.TrimSpace(register.CustomerNo) == "" {
+		return "", errors.NewValidationError("customer number cannot be empty")
+	}
+	virtualAccountNo := domain.VirtualAccountNo(register.PartnerServiceID, register.CustomerNo)
+	if err := validateVirtualAccountNo(virtualAccountNo, register.VirtualAccountNo); err != nil {
+		return "", err
+	}
+	switch domain.VirtualAccountTrxType(register.TrxType) {
+	case "", domain.VirtualAccountTrxTypeClosed:
+		if register.TotalAmount <= 0 {
+			return "", errors.NewValidationError("invalid total amount")
+		}
+	case domain.VirtualAccountTrxTypeOpen:
+		if register.TotalAmount < 0 {
+			return "", errors.NewValidationError("invalid total amount")
+		}
+	default:
+		return "", errors.NewValidationErrorf("invalid virtual account trx type %v", register.TrxType)
+	}
+	return virtualAccountNo, nil
+}
+
+func validateVirtualAccountNo(expected, virtualAccountNo string) error {
+	if strings.TrimSpace(virtualAccountNo) == "" {
+		return nil
+	}
+	if strings.ReplaceAll(virtualAccountNo, " ", "") != expected {
+		return errors.NewValidationError("virtual account number must be the partner service ID followed by the customer number")
+	}
+	return nil
+}
+
+func validatePaidAmount(virtualAccount *domain.VirtualAccount, payment *model.VirtualAccountPayment) error {
+	if payment.Currency != "" && payment.Currency != virtualAccount.Currency {
+		return errors.NewInvalidAmount("currency must be " + virtualAccount.Currency)
+	}
+	if payment.PaidAmount <= 0 {
+		return errors.NewInvalidAmount("invalid amount")
+	}
+	if virtualAccount.TrxType == domain.VirtualAccountTrxTypeClosed && payment.PaidAmount != virtualAccount.TotalAmount {
+		return errors.NewInvalidAmount("paid amount must equal the total amount of the bill")
+	}
+	return nil
+}
+
+func applyVirtualAccountRegister(virtualAccount *domain.VirtualAccount, register *model.VirtualAccountRegister) {
+	if register.Name != "" {
+		virtualAccount.Name = register.Name
+	}
+	virtualAccount.Email = register.Email
+	virtualAccount.Phone = register.Phone
+	if register.TrxID != "" {
+		virtualAccount.TrxID = register.TrxID
+	}
+	virtualAccount.TrxType = domain.VirtualAccountTrxType(register.TrxType)
+	if virtualAccount.TrxType == "" {
+		virtualAccount.TrxType = domain.VirtualAccountTrxTypeClosed
+	}
+	virtualAccount.TotalAmount = register.TotalAmount
+	virtualAccount.Currency = register.Currency
+	if virtualAccount.Currency == "" {
+		virtualAccount.Currency = defaultCurrency
+	}
+	virtualAccount.ExpiredDate = register.ExpiredDate
+	virtualAccount.Status = domain.VirtualAccountStatusActive
+	virtualAccount.UpdatedAt = time.Now()
+}
@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mrth1995/go-mockva/pkg/domain"
+	pkgErrors "github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/idgen"
+	"github.com/mrth1995/go-mockva/pkg/model"
+	mockRepo "github.com/mrth1995/go-mockva/pkg/repository/mock"
+	mockService "github.com/mrth1995/go-mockva/pkg/service/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestVirtualAccountService_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	account := getAccountDst()
+
+	accountService := mockService.NewMockAccountService(ctrl)
+	virtualAccountRepo := mockRepo.NewMockVirtualAccountRepository(ctrl)
+
+	virtualAccountRepo.EXPECT().FindByNo(ctx, "12345002").Return(nil, pkgErrors.NewVirtualAccountNotFound("12345002"))
+	accountService.EXPECT().FindByID(ctx, account.ID).Return(account, nil)
+	virtualAccountRepo.EXPECT().Save(ctx, gomock.Any()).Return(nil)
+
+	idGenerator, _ := idgen.New(idgen.KindReference, 1)
+	expectedIDs, _ := idgen.New(idgen.KindReference, 1)
+
+	virtualAccountService := NewVirtualAccountService(accountService, nil, virtualAccountRepo, nil, idGenerator)
+	virtualAccount, err := virtualAccountService.Create(ctx, &model.VirtualAccountRegister{
+		PartnerServiceID: "   12345",
+		CustomerNo:       account.ID,
+		VirtualAccountNo: "   12345002",
+		TotalAmount:      150_000,
+	})
+
+	assertions := require.New(t)
+	assertions.Nil(err)
+	assertions.Equal("12345002", virtualAccount.VirtualAccountNo)
+	assertions.Equal(account.ID, virtualAccount.AccountID)
+	assertions.Equal(account.Name, virtualAccount.Name)
+	assertions.Equal(expectedIDs.NewID(), virtualAccount.TrxID)
+	assertions.Equal(domain.VirtualAccountTrxTypeClosed, virtualAccount.TrxType)
+	assertions.Equal("IDR", virtualAccount.Currency)
+	assertions.Equal(domain.VirtualAccountStatusActive, virtualAccount.Status)
+}
+
+func TestVirtualAccountService_Create_AlreadyExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	virtualAccountRepo := mockRepo.NewMockVirtualAccountRepository(ctrl)
+	virtualAccountRepo.EXPECT().FindByNo(ctx, "12345002").Return(getVirtualAccount(), nil)
+
+	virtualAccountService := NewVirtualAccountService(nil, nil, virtualAccountRepo, nil, nil)
+	_, err := virtualAccountService.Create(ctx, &model.VirtualAccountRegister{
+		PartnerServiceID: "12345",
+		CustomerNo:       "002",
+		TotalAmount:      150_000,
+	})
+
+	require.ErrorIs(t, err, pkgErrors.ErrConflict)
+}
+
+func TestVirtualAccountService_Create_ValidationErrors(t *testing.T) {
+	virtualAccountService := NewVirtualAccountService(nil, nil, nil, nil, nil)
+
+	testCases := []struct {
+		name     string
+		register *model.VirtualAccountRegister
+	}{
+		{name: "Empty partner service ID", register: &model.VirtualAccountRegister{CustomerNo: "002", TotalAmount: 1}},
+		{name: "Empty customer number", register: &model.VirtualAccountRegister{PartnerServiceID: "12345", TotalAmount: 1}},
+		{name: "Mismatched virtual account number", register: &model.VirtualAccountRegister{PartnerServiceID: "12345", CustomerNo: "002", VirtualAccountNo: "12345003", TotalAmount: 1}},
+		{name: "Closed bill without amount", register: &model.VirtualAccountRegister{PartnerServiceID: "12345", CustomerNo: "002"}},
+		{name: "Unknown trx type", register: &model.VirtualAccountRegister{PartnerServiceID: "12345", CustomerNo: "002", TrxType: "X", TotalAmount: 1}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := virtualAccountService.Create(context.Background(), tc.register)
+			require.ErrorIs(t, err, pkgErrors.ErrValidation)
+		})
+	}
+}
+
+func TestVirtualAccountService_Pay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	settlement := getAccountBalance(getSettlementAccount(domain.CashInSettlementAccountID), 0)
+	accountDst := getAccountBalance(getAccountDst(), 0)
+
+	accountService := mockService.NewMockAccountService(ctrl)
+	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
+	txManager := mockRepo.NewMockDBTransactionManager(ctrl)
+	virtualAccountRepo := mockRepo.NewMockVirtualAccountRepository(ctrl)
+
+	txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
+		return fc(nil)
+	})
+	virtualAccountRepo.EXPECT().FindAndLockByNo(ctx, "12345002", gomock.Any()).Return(getVirtualAccount(), nil)
+	accountService.EXPECT().FindAndLockAccountBalance(ctx, domain.CashInSettlementAccountID, gomock.Any()).Return(settlement, nil)
+	accountService.EXPECT().FindAndLockAccountBalance(ctx, accountDst.ID, gomock.Any()).Return(accountDst, nil)
+	accountTrxRepo.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, trx *domain.AccountTransaction, tx *gorm.DB) error {
+			require.Equal(t, "SNAP", trx.Channel)
+			require.Equal(t, "PAY-001", trx.Reference)
+			return nil
+		})
+	accountService.EXPECT().UpdateBalance(ctx, gomock.Any(), gomock.Any()).Return(settlement, nil)
+	accountService.EXPECT().
+		UpdateBalance(ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, bal *domain.AccountBalance, tx *gorm.DB) (*domain.AccountBalance, error) {
+			require.Equal(t, float64(150_000), bal.Balance)
+			return bal, nil
+		})
+	virtualAccountRepo.EXPECT().Update(ctx, gomock.Any(), gomock.Any()).Return(nil)
+
+	accountTrxService := NewAccountTrxService(accountService, accountTrxRepo, txManager)
+	virtualAccountService := NewVirtualAccountService(accountService, accountTrxService, virtualAccountRepo, txManager, nil)
+	virtualAccount, err := virtualAccountService.Pay(ctx, &model.VirtualAccountPayment{
+		PartnerServiceID: "12345",
+		CustomerNo:       "002",
+		PaymentRequestID: "PAY-001",
+		PaidAmount:       150_000,
+		Currency:         "IDR",
+	})
+
+	assertions := require.New(t)
+	assertions.Nil(err)
+	assertions.Equal(domain.VirtualAccountStatusPaid, virtualAccount.Status)
+	assertions.Equal("PAY-001", virtualAccount.PaymentRequestID)
+	assertions.NotEmpty(virtualAccount.PaymentTransactionID)
+	assertions.Equal(float64(150_000), virtualAccount.PaidAmount)
+	assertions.NotNil(virtualAccount.PaidAt)
+}
+
+func TestVirtualAccountService_Pay_UpdateFailedRollsBackDeposit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	settlement := getAccountBalance(getSettlementAccount(domain.CashInSettlementAccountID), 0)
+	accountDst := getAccountBalance(getAccountDst(), 0)
+	updateErr := errors.New("connection reset")
+
+	accountService := mockService.NewMockAccountService(ctrl)
+	accountTrxRepo := mockRepo.NewMockAccountTransactionRepository(ctrl)
+	txManager := mockRepo.NewMockDBTransactionManager(ctrl)
+	virtualAccountRepo := mockRepo.NewMockVirtualAccountRepository(ctrl)
+
+	txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
+		require.ErrorIs(t, fc(nil), updateErr, "The deposit must be rolled back with the bill update")
+		return updateErr
+	})
+	virtualAccountRepo.EXPECT().FindAndLockByNo(ctx, "12345002", gomock.Any()).Return(getVirtualAccount(), nil)
+	accountService.EXPECT().FindAndLockAccountBalance(ctx, domain.CashInSettlementAccountID, gomock.Any()).Return(settlement, nil)
+	accountService.EXPECT().FindAndLockAccountBalance(ctx, accountDst.ID, gomock.Any()).Return(accountDst, nil)
+	accountTrxRepo.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	accountService.EXPECT().UpdateBalance(ctx, gomock.Any(), gomock.Any()).Return(settlement, nil).Times(2)
+	virtualAccountRepo.EXPECT().Update(ctx, gomock.Any(), gomock.Any()).Return(updateErr)
+
+	accountTrxService := NewAccountTrxService(accountService, accountTrxRepo, txManager)
+	virtualAccountService := NewVirtualAccountService(accountService, accountTrxService, virtualAccountRepo, txManager, nil)
+	virtualAccount, err := virtualAccountService.Pay(ctx, &model.VirtualAccountPayment{
+		PartnerServiceID: "12345",
+		CustomerNo:       "002",
+		PaymentRequestID: "PAY-001",
+		PaidAmount:       150_000,
+	})
+
+	assertions := require.New(t)
+	assertions.ErrorIs(err, updateErr)
+	assertions.Nil(virtualAccount)
+}
+
+func TestVirtualAccountService_Pay_Rejected(t *testing.T) {
+	expiredDate := time.Now().Add(-time.Hour)
+
+	testCases := []struct {
+		name           string
+		virtualAccount func(va *domain.VirtualAccount)
+		paidAmount     float64
+		expectedErr    error
+	}{
+		{
+			name:        "Closed bill paid with another amount",
+			paidAmount:  100_000,
+			expectedErr: pkgErrors.ErrInvalidAmount,
+		},
+		{
+			name: "Already paid",
+			virtualAccount: func(va *domain.VirtualAccount) {
+				va.Status = domain.VirtualAccountStatusPaid
+				va.PaymentRequestID = "PAY-000"
+			},
+			paidAmount:  150_000,
+			expectedErr: pkgErrors.ErrBillAlreadyPaid,
+		},
+		{
+			name:           "Expired",
+			virtualAccount: func(va *domain.VirtualAccount) { va.ExpiredDate = &expiredDate },
+			paidAmount:     150_000,
+			expectedErr:    pkgErrors.ErrVirtualAccountExpired,
+		},
+		{
+			name:           "Deleted",
+			virtualAccount: func(va *domain.VirtualAccount) { va.Status = domain.VirtualAccountStatusDeleted },
+			paidAmount:     150_000,
+			expectedErr:    pkgErrors.ErrVirtualAccountNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			virtualAccount := getVirtualAccount()
+			if tc.virtualAccount != nil {
+				tc.virtualAccount(virtualAccount)
+			}
+			txManager := mockRepo.NewMockDBTransactionManager(ctrl)
+			txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
+				return fc(nil)
+			})
+			virtualAccountRepo := mockRepo.NewMockVirtualAccountRepository(ctrl)
+			virtualAccountRepo.EXPECT().FindAndLockByNo(ctx, "12345002", gomock.Any()).Return(virtualAccount, nil)
+
+			virtualAccountService := NewVirtualAccountService(nil, nil, virtualAccountRepo, txManager, nil)
+			_, err := virtualAccountService.Pay(ctx, &model.VirtualAccountPayment{
+				PartnerServiceID: "12345",
+				CustomerNo:       "002",
+				PaymentRequestID: "PAY-001",
+				PaidAmount:       tc.paidAmount,
+			})
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestVirtualAccountService_Pay_Retry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	paid := getVirtualAccount()
+	paid.Status = domain.VirtualAccountStatusPaid
+	paid.PaymentRequestID = "PAY-001"
+	paid.PaymentTransactionID = "TRX-001"
+
+	txManager := mockRepo.NewMockDBTransactionManager(ctrl)
+	txManager.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fc func(tx *gorm.DB) error) error {
+		return fc(nil)
+	})
+	virtualAccountRepo := mockRepo.NewMockVirtualAccountRepository(ctrl)
+	virtualAccountRepo.EXPECT().FindAndLockByNo(ctx, "12345002", gomock.Any()).Return(paid, nil)
+
+	virtualAccountService := NewVirtualAccountService(nil, nil, virtualAccountRepo, txManager, nil)
+	virtualAccount, err := virtualAccountService.Pay(ctx, &model.VirtualAccountPayment{
+		PartnerServiceID: "12345",
+		CustomerNo:       "002",
+		PaymentRequestID: "PAY-001",
+		PaidAmount:       150_000,
+	})
+
+	assertions := require.New(t)
+	assertions.Nil(err)
+	assertions.Equal("TRX-001", virtualAccount.PaymentTransactionID)
+}
+
+func TestVirtualAccountService_FindPayment_NotPaid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	virtualAccountRepo := mockRepo.NewMockVirtualAccountRepository(ctrl)
+	virtualAccountRepo.EXPECT().FindByNo(ctx, "12345002").Return(getVirtualAccount(), nil)
+
+	virtualAccountService := NewVirtualAccountService(nil, nil, virtualAccountRepo, nil, nil)
+	_, err := virtualAccountService.FindPayment(ctx, "12345", "002", "PAY-001")
+
+	require.ErrorIs(t, err, pkgErrors.ErrTransactionNotFound)
+}
+
+func getVirtualAccount() *domain.VirtualAccount {
+	account := getAccountDst()
+	return &domain.VirtualAccount{
+		VirtualAccountNo: "12345002",
+		PartnerServiceID: "12345",
+		CustomerNo:       account.ID,
+		AccountID:        account.ID,
+		Name:             account.Name,
+		TrxType:          domain.VirtualAccountTrxTypeClosed,
+		TotalAmount:      150_000,
+		Currency:         "IDR",
+		Status:           domain.VirtualAccountStatusActive,
+	}
+}
@@ -0,0 +1,135 @@
+// Package signature signs and verifies SNAP style request signatures.
+//
+// Token requests are signed asymmetrically: X-SIGNATURE is the base64 encoded SHA256withRSA
+// or SHA256withECDSA signature of "<client ID>|<X-TIMESTAMP>".
+//
+// Service requests are signed symmetrically: X-SIGNATURE is the base64 encoded HMAC-SHA512,
+// keyed with the client secret, of
+// "<HTTP method>:<endpoint URL>:<access token>:<lowercase hex SHA-256 of the minified body>:<X-TIMESTAMP>".
+// The access token is empty when the request carries none.
+package signature
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// RouteKindKey is the route metadata key telling which kind of signature a route expects,
+// KindAsymmetric or KindSymmetric. Routes without it expect symmetric signatures.
+const RouteKindKey = "signature.kind"
+
+const (
+	KindAsymmetric = "asymmetric"
+	KindSymmetric  = "symmetric"
+)
+
+// ParsePublicKey parses a PEM encoded PKIX RSA or ECDSA public key.
+func ParsePublicKey(publicKeyPEM string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(publicKeyPEM))
+	if block == nil {
+		return nil, errors.New("public key is not PEM encoded")
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse public key: %w", err)
+	}
+	switch publicKey.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey:
+		return publicKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+}
+
+// AsymmetricStringToSign returns the string signed by token requests.
+func AsymmetricStringToSign(clientID, timestamp string) string {
+	return clientID + "|" + timestamp
+}
+
+// SignAsymmetric signs stringToSign with an RSA or ECDSA private key and returns the base64 signature.
+func SignAsymmetric(privateKey crypto.Signer, stringToSign string) (string, error) {
+	digest := sha256.Sum256([]byte(stringToSign))
+	signed, err := privateKey.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(signed), nil
+}
+
+// VerifyAsymmetric verifies the base64 signature of stringToSign with an RSA or ECDSA public key.
+func VerifyAsymmetric(publicKey crypto.PublicKey, stringToSign, signature string) error {
+	signed, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	digest := sha256.Sum256([]byte(stringToSign))
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		if rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signed) != nil {
+			return ErrInvalidSignature
+		}
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest[:], signed) {
+			return ErrInvalidSignature
+		}
+	default:
+		return fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+	return nil
+}
+
+// SymmetricStringToSign returns the string signed by service requests. The JSON body is
+// minified before it is hashed, so formatting differences do not change the signature.
+func SymmetricStringToSign(method, endpointURL, accessToken string, body []byte, timestamp string) (string, error) {
+	minified := new(bytes.Buffer)
+	if len(bytes.TrimSpace(body)) > 0 {
+		if err := json.Compact(minified, body); err != nil {
+			return "", fmt.Errorf("request body is not valid JSON: %w", err)
+		}
+	}
+	bodyHash := sha256.Sum256(minified.Bytes())
+	return strings.Join([]string{
+		strings.ToUpper(method),
+		endpointURL,
+		accessToken,
+		hex.EncodeToString(bodyHash[:]),
+		timestamp,
+	}, ":"), nil
+}
+
+// SignSymmetric returns the base64 HMAC-SHA512 of stringToSign keyed with secret.
+func SignSymmetric(secret, stringToSign string) string {
+	mac := hmac.New(sha512.New, []byte(secret))
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySymmetric verifies the base64 HMAC-SHA512 signature of stringToSign in constant time.
+func VerifySymmetric(secret, stringToSign, signature string) error {
+	signed, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	mac := hmac.New(sha512.New, []byte(secret))
+	mac.Write([]byte(stringToSign))
+	if !hmac.Equal(mac.Sum(nil), signed) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
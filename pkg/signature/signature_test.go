@@ -0,0 +1,71 @@
+package signature
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsymmetric(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	for name, privateKey := range map[string]crypto.Signer{"RSA": rsaKey, "ECDSA": ecdsaKey} {
+		t.Run(name, func(t *testing.T) {
+			assertions := require.New(t)
+			publicKey, err := ParsePublicKey(encodePublicKey(t, privateKey.Public()))
+			assertions.NoError(err)
+
+			stringToSign := AsymmetricStringToSign("client-001", "2024-01-01T10:00:00+07:00")
+			assertions.Equal("client-001|2024-01-01T10:00:00+07:00", stringToSign)
+			signed, err := SignAsymmetric(privateKey, stringToSign)
+			assertions.NoError(err)
+
+			assertions.NoError(VerifyAsymmetric(publicKey, stringToSign, signed))
+			assertions.ErrorIs(VerifyAsymmetric(publicKey, "client-001|2024-01-01T10:00:01+07:00", signed), ErrInvalidSignature)
+			assertions.ErrorIs(VerifyAsymmetric(publicKey, stringToSign, "not base64"), ErrInvalidSignature)
+		})
+	}
+}
+
+func TestParsePublicKey_Invalid(t *testing.T) {
+	_, err := ParsePublicKey("not a key")
+	require.Error(t, err)
+}
+
+func TestSymmetric(t *testing.T) {
+	assertions := require.New(t)
+
+	stringToSign, err := SymmetricStringToSign("post", "/mockva/snap/v1.0/transfer-va/inquiry", "token",
+		[]byte("{\n  \"customerNo\": \"002\"\n}"), "2024-01-01T10:00:00+07:00")
+	assertions.NoError(err)
+	assertions.Equal("POST:/mockva/snap/v1.0/transfer-va/inquiry:token:"+
+		"14b63621759355cbd0894f3f3778f9781013a53b4cc6bcdbb3a5d0459d1b805d:2024-01-01T10:00:00+07:00", stringToSign)
+
+	minified, err := SymmetricStringToSign("POST", "/mockva/snap/v1.0/transfer-va/inquiry", "token",
+		[]byte(`{"customerNo":"002"}`), "2024-01-01T10:00:00+07:00")
+	assertions.NoError(err)
+	assertions.Equal(minified, stringToSign, "body formatting does not change the string to sign")
+
+	signed := SignSymmetric("secret", stringToSign)
+	assertions.NoError(VerifySymmetric("secret", stringToSign, signed))
+	assertions.ErrorIs(VerifySymmetric("other secret", stringToSign, signed), ErrInvalidSignature)
+
+	_, err = SymmetricStringToSign("POST", "/", "", []byte("{"), "2024-01-01T10:00:00+07:00")
+	assertions.Error(err)
+}
+
+func encodePublicKey(t *testing.T, publicKey crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
@@ -0,0 +1,23 @@
+// Package tenant carries the tenant a request operates on through the request context. Accounts,
+// balances, transactions and virtual accounts belong to a tenant and are only visible within it.
+package tenant
+
+import "context"
+
+// DefaultID is the tenant of requests that do not name one. It always exists.
+const DefaultID = "default"
+
+type tenantKey struct{}
+
+// WithID returns a copy of ctx operating on the tenant id.
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, id)
+}
+
+// FromContext returns the tenant ctx operates on, DefaultID when it names none.
+func FromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(tenantKey{}).(string); ok && id != "" {
+		return id
+	}
+	return DefaultID
+}
@@ -0,0 +1,89 @@
+// Package tracing sets up OpenTelemetry tracing and provides the tracer of the mockva spans.
+// Incoming requests continue the W3C trace context of the caller, so the traces of the clients
+// carry on into the services, repositories and database queries of mockva.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/mrth1995/go-mockva/pkg/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Exporters the spans can be sent to.
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+const (
+	serviceName = "mockva"
+	tracerName  = "github.com/mrth1995/go-mockva"
+)
+
+// Setup installs the global tracer provider and the W3C trace context propagator. With the none
+// exporter spans are not recorded, but the trace context of requests is still propagated.
+// The OTLP exporter is configured with the standard OTEL_EXPORTER_OTLP_* environment variables,
+// e.g. OTEL_EXPORTER_OTLP_ENDPOINT=http://collector:4318.
+// Parameters:
+//   - ctx: The context of the exporter connection
+//   - exporter: ExporterNone, ExporterStdout or ExporterOTLP
+//   - sampleRatio: The fraction of the traces started by mockva that are sampled, callers decide for theirs
+//
+// Returns:
+//   - func(context.Context) error: Flushes the pending spans and stops the exporter
+//   - error: If the exporter is unknown or cannot be created
+func Setup(ctx context.Context, exporter string, sampleRatio float64) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var spanExporter sdktrace.SpanExporter
+	var err error
+	switch exporter {
+	case "", ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		spanExporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case ExporterOTLP:
+		spanExporter, err = otlptracehttp.New(ctx)
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q, expected none, stdout or otlp", exporter)
+	}
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(
+			semconv.ServiceName(serviceName),
+			semconv.ServiceVersion(version.Version),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Start starts a span named name as a child of the span of ctx.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, opts...)
+}
+
+// End records err on span, if any, and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
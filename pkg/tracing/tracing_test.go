@@ -0,0 +1,41 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSetup(t *testing.T) {
+	assertions := require.New(t)
+
+	shutdown, err := Setup(context.Background(), ExporterNone, 1)
+	assertions.Nil(err)
+	assertions.Nil(shutdown(context.Background()))
+
+	_, err = Setup(context.Background(), "zipkin", 1)
+	assertions.NotNil(err)
+}
+
+func TestEnd(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	_, failed := tracer.Start(context.Background(), "failed")
+	End(failed, errors.New("insufficient amount"))
+	_, succeeded := tracer.Start(context.Background(), "succeeded")
+	End(succeeded, nil)
+
+	spans := recorder.Ended()
+	assertions := require.New(t)
+	assertions.Len(spans, 2)
+	assertions.Equal(codes.Error, spans[0].Status().Code)
+	assertions.Equal("insufficient amount", spans[0].Status().Description)
+	assertions.Len(spans[0].Events(), 1, "the error is recorded")
+	assertions.Equal(codes.Unset, spans[1].Status().Code)
+}
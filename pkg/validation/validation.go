@@ -0,0 +1,119 @@
+// Package validation validates request models declaratively using their `validate` struct tags
+// and reports every invalid field at once.
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	endpointError "github.com/mrth1995/go-mockva/pkg/errors"
+)
+
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return field.Name
+		}
+		return name
+	})
+	_ = v.RegisterValidation("date", func(fl validator.FieldLevel) bool {
+		value := fl.Field().String()
+		if value == "" {
+			return true
+		}
+		_, err := time.Parse(time.DateOnly, value)
+		return err == nil
+	})
+	return v
+}
+
+// Struct validates s against its `validate` tags.
+// Returns:
+//   - error: nil when s is valid, otherwise a validation EndpointError listing every invalid field
+func Struct(s any) error {
+	err := validate.Struct(s)
+	if err == nil {
+		return nil
+	}
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return endpointError.NewValidationError(err.Error())
+	}
+	structType := reflect.Indirect(reflect.ValueOf(s)).Type()
+	fieldErrors := make([]endpointError.FieldError, 0, len(validationErrors))
+	for _, fieldErr := range validationErrors {
+		fieldErrors = append(fieldErrors, endpointError.FieldError{
+			Field:   fieldPath(fieldErr),
+			Message: message(structType, fieldErr),
+		})
+	}
+	return endpointError.NewFieldValidationError(fieldErrors)
+}
+
+func message(structType reflect.Type, fieldErr validator.FieldError) string {
+	switch fieldErr.Tag() {
+	case "required":
+		return "is required"
+	case "max":
+		if fieldErr.Kind() == reflect.String {
+			return fmt.Sprintf("must be at most %s characters", fieldErr.Param())
+		}
+		return fmt.Sprintf("must be at most %s", fieldErr.Param())
+	case "min":
+		if fieldErr.Kind() == reflect.String && fieldErr.Param() == "1" {
+			return "cannot be empty"
+		}
+		if fieldErr.Kind() == reflect.String {
+			return fmt.Sprintf("must be at least %s characters", fieldErr.Param())
+		}
+		return fmt.Sprintf("must be at least %s", fieldErr.Param())
+	case "len":
+		if fieldErr.Kind() == reflect.String {
+			return fmt.Sprintf("must be exactly %s characters", fieldErr.Param())
+		}
+		return fmt.Sprintf("must have exactly %s items", fieldErr.Param())
+	case "oneof":
+		return "must be one of " + strings.Join(strings.Fields(fieldErr.Param()), ", ")
+	case "gt":
+		return fmt.Sprintf("must be greater than %s", fieldErr.Param())
+	case "nefield":
+		return fmt.Sprintf("must be different from %s", jsonName(structType, fieldErr.Param()))
+	case "date":
+		return "must be a date in YYYY-MM-DD format"
+	default:
+		return fmt.Sprintf("failed %s validation", fieldErr.Tag())
+	}
+}
+
+// fieldPath returns the dotted JSON path of the invalid field, e.g. paidAmount.value for nested structs.
+func fieldPath(fieldErr validator.FieldError) string {
+	_, path, found := strings.Cut(fieldErr.Namespace(), ".")
+	if !found {
+		return fieldErr.Field()
+	}
+	return path
+}
+
+// jsonName returns the JSON name of the named field of structType, as reported in field errors.
+func jsonName(structType reflect.Type, fieldName string) string {
+	field, ok := structType.FieldByName(fieldName)
+	if !ok {
+		return fieldName
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return fieldName
+	}
+	return name
+}
@@ -0,0 +1,55 @@
+package validation
+
+import (
+	"testing"
+
+	endpointError "github.com/mrth1995/go-mockva/pkg/errors"
+	"github.com/mrth1995/go-mockva/pkg/model"
+	"github.com/mrth1995/go-mockva/pkg/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStruct_AccountRegister(t *testing.T) {
+	err := Struct(&model.AccountRegister{
+		ID:        "100",
+		BirthDate: "11-03-1996",
+	})
+
+	assertions := require.New(t)
+	assertions.ErrorIs(err, endpointError.ErrValidation)
+	assertions.Equal([]endpointError.FieldError{
+		{Field: "name", Message: "is required"},
+		{Field: "birthDate", Message: "must be a date in YYYY-MM-DD format"},
+	}, endpointError.From(err).Errors, "Every invalid field should be reported")
+}
+
+func TestStruct_AccountEdit(t *testing.T) {
+	assertions := require.New(t)
+	assertions.Nil(Struct(&model.AccountEdit{}), "Omitted fields are not validated")
+
+	err := Struct(&model.AccountEdit{
+		Name:      utils.ToStringPointer(""),
+		BirthDate: utils.ToStringPointer("1996/03/11"),
+	})
+	assertions.Equal([]endpointError.FieldError{
+		{Field: "name", Message: "cannot be empty"},
+		{Field: "birthDate", Message: "must be a date in YYYY-MM-DD format"},
+	}, endpointError.From(err).Errors)
+}
+
+func TestStruct_AccountFundTransfer(t *testing.T) {
+	assertions := require.New(t)
+	assertions.Nil(Struct(&model.AccountFundTransfer{AccountSrcID: "001", AccountDstID: "002", Amount: 1}))
+
+	err := Struct(&model.AccountFundTransfer{
+		AccountSrcID: "001",
+		AccountDstID: "001",
+		Amount:       -1,
+		PurposeCode:  "123456789",
+	})
+	assertions.Equal([]endpointError.FieldError{
+		{Field: "accountDstId", Message: "must be different from accountSrcId"},
+		{Field: "amount", Message: "must be greater than 0"},
+		{Field: "purposeCode", Message: "must be at most 8 characters"},
+	}, endpointError.From(err).Errors)
+}